@@ -7,11 +7,14 @@ import (
 
 	"github.com/ozoncp/ocp-experience-api/internal/metrics"
 	"github.com/ozoncp/ocp-experience-api/internal/producer"
+	"github.com/ozoncp/ocp-experience-api/internal/purger"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/opentracing/opentracing-go"
@@ -24,18 +27,23 @@ import (
 	jaegerMetrics "github.com/uber/jaeger-lib/metrics"
 
 	"github.com/ozoncp/ocp-experience-api/config"
+	"github.com/ozoncp/ocp-experience-api/internal/actor"
 	"github.com/ozoncp/ocp-experience-api/internal/api"
+	"github.com/ozoncp/ocp-experience-api/internal/consistency"
 	"github.com/ozoncp/ocp-experience-api/internal/db"
 	"github.com/ozoncp/ocp-experience-api/internal/repo"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/jmoiron/sqlx"
 
 	"google.golang.org/grpc"
+	"github.com/golang/protobuf/proto"
 
 	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
 )
 
 var isServiceReady atomic.Value
+var schemaVersion atomic.Value
 
 const (
 	apiKafkaTopic = "ocp_experience_events"
@@ -88,16 +96,86 @@ func initTracing(config *config.Configuration) {
 	opentracing.SetGlobalTracer(tracer)
 }
 
-// builds experience API service
-func createExperienceApi(config *config.Configuration) *api.ExperienceAPI {
-	database := db.Connect(config.ExperienceDNS)
+// starts removed experiences and expired idempotency keys purging
+func runPurger(config *config.Configuration, repo repo.IRepo) {
+	retention := time.Duration(config.DeletedRetentionHours) * time.Hour
+	interval := time.Duration(config.PurgeIntervalMinutes) * time.Minute
+	keyInterval := time.Duration(config.IdempotencyKeyPurgeIntervalMinutes) * time.Minute
+
+	if interval == 0 {
+		log.Info().Msg("removed experiences purging is disabled")
+	}
+
+	if keyInterval == 0 {
+		log.Info().Msg("expired idempotency keys purging is disabled")
+	}
+
+	if interval == 0 && keyInterval == 0 {
+		return
+	}
 
-	repo := repo.NewRepo(database)
+	purger.NewPurger(repo, retention, interval, keyInterval).Init()
+}
+
+// applies or verifies schema migrations selected in config
+func migrateSchema(config *config.Configuration, database *sqlx.DB, dialect string) {
+	mode := config.Migrations
+
+	if mode == "" {
+		mode = db.MigrateAuto
+	}
+
+	version, err := db.Migrate(context.Background(), database, dialect, mode)
+
+	if err != nil {
+		log.Panic().Msgf("failed to migrate schema: %v", err)
+	}
+
+	if mode != db.MigrateOff {
+		log.Info().Msgf("schema version is %d", version)
+		schemaVersion.Store(version)
+	}
+}
+
+// creates experience storage selected in config
+func createRepo(config *config.Configuration) repo.IRepo {
+	switch config.Storage {
+	case "", "postgres":
+		database := db.Connect(config.ExperienceDNS)
+		migrateSchema(config, database, db.PostgresDialect)
+
+		if config.ExperienceReplicaDNS != "" {
+			return repo.NewReplicatedRepo(database, db.Connect(config.ExperienceReplicaDNS))
+		}
+
+		return repo.NewRepo(database)
+	case "sqlite":
+		database := db.ConnectSQLite(config.SQLiteDSN)
+		migrateSchema(config, database, db.SQLiteDialect)
+
+		return repo.NewSQLiteRepo(database)
+	case "memory":
+		log.Info().Msg("experiences are stored in memory")
+		return repo.NewMemoryRepo()
+	}
+
+	log.Panic().Msgf("invalid configuration: unknown storage %q", config.Storage)
+	return nil
+}
+
+// builds experience API service
+func createExperienceApi(config *config.Configuration, repo repo.IRepo) *api.ExperienceAPI {
 	prom := metrics.NewReporter()
 	producer := createKafkaProducer(config)
 	tracer := opentracing.GlobalTracer()
 
-	return api.NewExperienceApi(repo, config.ExperienceBatchSize, prom, producer, tracer)
+	overlapPolicy, err := api.ParseOverlapPolicy(config.OverlapPolicy)
+
+	if err != nil {
+		log.Panic().Msgf("invalid configuration: %v", err)
+	}
+
+	return api.NewExperienceApi(repo, config.ExperienceBatchSize, prom, producer, tracer, overlapPolicy)
 }
 
 func run(config *config.Configuration) error {
@@ -107,8 +185,13 @@ func run(config *config.Configuration) error {
 		log.Panic().Msgf("failed to listen: %v", err)
 	}
 
-	server := grpc.NewServer()
-	experienceApi := createExperienceApi(config)
+	repo := createRepo(config)
+	runPurger(config, repo)
+
+	idempotencyTTL := time.Duration(config.IdempotencyKeyTTLHours) * time.Hour
+	idempotencyLease := time.Duration(config.IdempotencyKeyLeaseSeconds) * time.Second
+	server := grpc.NewServer(grpc.UnaryInterceptor(api.NewIdempotencyInterceptor(repo, idempotencyTTL, idempotencyLease)))
+	experienceApi := createExperienceApi(config, repo)
 
 	desc.RegisterOcpExperienceApiServer(server, experienceApi)
 
@@ -128,7 +211,11 @@ func runJSON(config *config.Configuration) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithForwardResponseOption(etagResponseModifier),
+	)
+
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	err := desc.RegisterOcpExperienceApiHandlerFromEndpoint(ctx, mux, config.GRPCServerEndpoint, opts)
@@ -144,13 +231,43 @@ func runJSON(config *config.Configuration) {
 	}
 }
 
+// headerMatcher forwards If-Match, X-Actor, Idempotency-Key and X-Read-Your-Writes headers to gRPC metadata
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, api.IfMatchHeader) {
+		return api.IfMatchHeader, true
+	}
+
+	if strings.EqualFold(key, actor.MetadataKey) {
+		return actor.MetadataKey, true
+	}
+
+	if strings.EqualFold(key, api.IdempotencyKeyHeader) {
+		return api.IdempotencyKeyHeader, true
+	}
+
+	if strings.EqualFold(key, consistency.MetadataKey) {
+		return consistency.MetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// etagResponseModifier sets ETag header to a described experience version
+func etagResponseModifier(_ context.Context, w http.ResponseWriter, message proto.Message) error {
+	if response, ok := message.(*desc.DescribeExperienceV1Response); ok && response.Experience != nil {
+		w.Header().Set("ETag", api.FormatETag(response.Experience.Version))
+	}
+
+	return nil
+}
+
 // runs metrics
 func runUtilityServer() {
 	http.Handle("/metrics", promhttp.Handler())
 
 	http.Handle("/live", http.HandlerFunc(liveHandler))
 	http.Handle("/health", http.HandlerFunc(healthHandler))
-	http.Handle("/ready", readyHandler(&isServiceReady))
+	http.Handle("/ready", readyHandler(&isServiceReady, &schemaVersion))
 
 	if err := http.ListenAndServe(":9100", nil); err != nil {
 		log.Panic().Msgf("http endpoint failed: %v", err)
@@ -196,14 +313,32 @@ func healthHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
-// readyHandler is a Readiness probe
-func readyHandler(isReady *atomic.Value) http.HandlerFunc {
+// readyHandler is a Readiness probe, reports schema version if migrations are enabled
+func readyHandler(isReady *atomic.Value, version *atomic.Value) http.HandlerFunc {
 	return func(w http.ResponseWriter, _ *http.Request) {
-		if isReady == nil || !isReady.Load().(bool) {
+		if ready, ok := isReady.Load().(bool); !ok || !ready {
 			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
 			return
 		}
 
-		w.WriteHeader(http.StatusOK)
+		status := map[string]int64{}
+
+		if schema, ok := version.Load().(int64); ok {
+			status["schema_version"] = schema
+		}
+
+		body, err := json.Marshal(status)
+
+		if err != nil {
+			log.Error().Err(err).Msgf("Could not encode ready data: %v", err)
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err = w.Write(body); err != nil {
+			log.Error().Err(err).Msgf("Could not write body: %v", err)
+		}
 	}
 }
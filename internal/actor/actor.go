@@ -0,0 +1,35 @@
+package actor
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is a gRPC metadata key carrying caller identity
+const MetadataKey = "x-actor"
+
+// context key type
+type actorKey struct{}
+
+// NewContext returns a copy of ctx carrying caller identity
+func NewContext(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns caller identity set by NewContext or passed in incoming gRPC metadata.
+// Returns empty string if caller is unknown
+func FromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok {
+		return actor
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
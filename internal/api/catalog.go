@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ozoncp/ocp-experience-api/internal/consistency"
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+
+	repository "github.com/ozoncp/ocp-experience-api/internal/repo"
+)
+
+// typeCatalog caches experience type names and level ladders read while handling a request
+type typeCatalog struct {
+	repo    repository.IRepo
+	names   map[uint64]string
+	ladders map[uint64]models.Ladder
+	loaded  map[uint64]bool
+}
+
+// newTypeCatalog creates an empty catalog cache
+func newTypeCatalog(repo repository.IRepo) *typeCatalog {
+	return &typeCatalog{
+		repo:    repo,
+		names:   make(map[uint64]string),
+		ladders: make(map[uint64]models.Ladder),
+		loaded:  make(map[uint64]bool),
+	}
+}
+
+// load reads types of experiences missing in the cache, types below the lower bound are skipped
+func (c *typeCatalog) load(ctx context.Context, experiences []models.Experience) error {
+	ids := make([]uint64, 0, len(experiences))
+	seen := make(map[uint64]bool, len(experiences))
+
+	for _, experience := range experiences {
+		id := experience.Type
+
+		if id >= models.MinType && !c.loaded[id] && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	names, err := c.repo.TypeNames(ctx, ids)
+
+	if err != nil {
+		return err
+	}
+
+	ladders, err := c.repo.Ladders(ctx, ids)
+
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			c.names[id] = name
+		}
+
+		c.ladders[id] = ladders[id]
+		c.loaded[id] = true
+	}
+
+	return nil
+}
+
+// violations checks written experience type exists in the catalog and level is on the type ladder.
+// Experience type should be loaded, field names a violated experience field
+func (c *typeCatalog) violations(experience models.Experience, written []string, field func(name string) string) []models.FieldViolation {
+	typeWritten := containsField(written, models.TypeField)
+	levelWritten := containsField(written, models.LevelField)
+
+	if !typeWritten && !levelWritten || !c.loaded[experience.Type] {
+		return nil
+	}
+
+	if _, ok := c.names[experience.Type]; !ok {
+		if typeWritten {
+			return []models.FieldViolation{{Field: field(models.TypeField), Description: "unknown experience type"}}
+		}
+
+		return nil
+	}
+
+	if experience.Level >= models.MinLevel && !c.ladders[experience.Type].Allows(experience.Level) {
+		return []models.FieldViolation{{Field: field(models.LevelField), Description: "must be on the experience type ladder"}}
+	}
+
+	return nil
+}
+
+// unknownTypeError returns validation error of experience written with a type removed from the catalog
+// after the catalog was loaded
+func unknownTypeError() *models.ValidationError {
+	return &models.ValidationError{
+		Violations: []models.FieldViolation{{Field: fieldName(models.TypeField), Description: "unknown experience type"}},
+	}
+}
+
+// withStoredFields returns updated experience with type and level, from and to set in pairs, if only one field
+// of a pair is written the other is read from the stored experience. Missing experience is returned as is
+func (r *ExperienceAPI) withStoredFields(ctx context.Context, experience models.Experience, written []string) (models.Experience, error) {
+	typeWritten := containsField(written, models.TypeField)
+	levelWritten := containsField(written, models.LevelField)
+	fromWritten := containsField(written, models.FromField)
+	toWritten := containsField(written, models.ToField)
+
+	if typeWritten == levelWritten && fromWritten == toWritten {
+		return experience, nil
+	}
+
+	// merged values must not lag behind a read replica
+	stored, err := r.repo.Describe(consistency.NewContext(ctx), experience.Id)
+
+	if errors.Is(err, repository.NotFound) {
+		return experience, nil
+	}
+
+	if err != nil {
+		return experience, err
+	}
+
+	if typeWritten && !levelWritten {
+		experience.Level = stored.Level
+	} else if levelWritten && !typeWritten {
+		experience.Type = stored.Type
+	}
+
+	if fromWritten && !toWritten {
+		experience.To = stored.To
+	} else if toWritten && !fromWritten {
+		experience.From = stored.From
+	}
+
+	return experience, nil
+}
+
+// intervalViolations checks interval order of experience merged with stored from or to, if only one of them is written.
+// Interval of both written from and to is checked by ValidateFields
+func intervalViolations(merged models.Experience, written []string) []models.FieldViolation {
+	fromWritten := containsField(written, models.FromField)
+	toWritten := containsField(written, models.ToField)
+
+	if fromWritten == toWritten || merged.From.IsZero() || merged.IsOngoing() || merged.From.Before(merged.To) {
+		return nil
+	}
+
+	if toWritten {
+		return []models.FieldViolation{{Field: models.ToField, Description: "must be after from"}}
+	}
+
+	return []models.FieldViolation{{Field: models.FromField, Description: "must be before to"}}
+}
+
+// fieldName returns field name as is
+func fieldName(name string) string {
+	return name
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// IfMatchHeader is a metadata key of the If-Match HTTP header forwarded by the gateway
+const IfMatchHeader = "if-match"
+
+// FormatETag converts experience version to ETag header value
+func FormatETag(version uint64) string {
+	return strconv.Quote(strconv.FormatUint(version, 10))
+}
+
+// ParseETag converts ETag header value to experience version
+func ParseETag(etag string) (uint64, error) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	return strconv.ParseUint(strings.Trim(etag, `"`), 10, 64)
+}
+
+// expectedVersion returns requested experience version.
+// If version is not set, it is taken from If-Match metadata
+func expectedVersion(ctx context.Context, version uint64) (uint64, error) {
+	if version != 0 {
+		return version, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(IfMatchHeader)
+
+	if len(values) == 0 || values[0] == "*" {
+		return 0, nil
+	}
+
+	version, err := ParseETag(values[0])
+
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid If-Match header: %v", values[0])
+	}
+
+	return version, nil
+}
@@ -3,10 +3,17 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/ozoncp/ocp-experience-api/internal/utils"
 
 	"github.com/opentracing/opentracing-go"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -21,6 +28,9 @@ import (
 	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
 )
 
+// maxImportRejections limits number of rejection reasons returned by import
+const maxImportRejections = 100
+
 type validator interface {
 	Validate() error
 }
@@ -30,7 +40,8 @@ func NewExperienceApi(r repository.IRepo,
 	batchSize uint64,
 	reporter metrics.Reporter,
 	producer producer.Producer,
-	tracer opentracing.Tracer) *ExperienceAPI {
+	tracer opentracing.Tracer,
+	overlapPolicy OverlapPolicy) *ExperienceAPI {
 
 	return &ExperienceAPI{
 		repo: r,
@@ -38,16 +49,18 @@ func NewExperienceApi(r repository.IRepo,
 		metrics : reporter,
 		producer: producer,
 		tracer: tracer,
+		overlapPolicy: overlapPolicy,
 	}
 }
 
 type ExperienceAPI struct {
 	desc.UnimplementedOcpExperienceApiServer
-	repo      repository.IRepo
-	batchSize uint64
-	metrics   metrics.Reporter
-	producer  producer.Producer
-	tracer    opentracing.Tracer
+	repo          repository.IRepo
+	batchSize     uint64
+	metrics       metrics.Reporter
+	producer      producer.Producer
+	tracer        opentracing.Tracer
+	overlapPolicy OverlapPolicy
 }
 
 // ListExperienceV1 returns a list of user Requests
@@ -61,20 +74,32 @@ func (r *ExperienceAPI) ListExperienceV1(ctx context.Context, req *desc.ListExpe
 		return nil, err
 	}
 
-	experiences, err := r.repo.List(ctx, req.Limit, req.Offset)
+	filter := models.ConvertAPIToFilter(req.Filter)
+	order := models.ConvertAPIToOrder(req.OrderBy)
+	page, err := r.listPage(ctx, "ListExperienceV1", filter, order, req.PageToken, req.Limit, req.Offset)
 
 	if err != nil {
-		log.Error().
-			Err(err).
-			Str("endpoint", "ListExperienceV1").
-			Uint64("limit", req.Limit).
-			Uint64("offset", req.Offset).
-			Msgf("Failed to list experiences")
-
-		r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
 		return nil, err
 	}
 
+	experiences := page.experiences
+
+	var totalCount uint64 = 0
+
+	if !req.SkipTotalCount {
+		totalCount, err = r.repo.Count(ctx, filter)
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("endpoint", "ListExperienceV1").
+				Msgf("Failed to count experiences")
+
+			r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+			return nil, err
+		}
+	}
+
 	result := make([]*desc.Experience, 0, len(experiences))
 	eventMessages := make([]producer.EventMsg, 0, len(experiences))
 
@@ -85,12 +110,134 @@ func (r *ExperienceAPI) ListExperienceV1(ctx context.Context, req *desc.ListExpe
 		r.producer.Send(eventMessages...)
 	}
 
+	if req.IncludeTypeName {
+		if err := r.setTypeNames(ctx, result); err != nil {
+			log.Error().
+				Err(err).
+				Str("endpoint", "ListExperienceV1").
+				Msgf("Failed to read experience type names")
+
+			return nil, err
+		}
+	}
+
 	r.metrics.IncList(1, "ListExperienceV1")
 	return &desc.ListExperienceV1Response{
-		Experiences: result,
+		Experiences:   result,
+		NextPageToken: page.nextPageToken,
+		TotalCount:    totalCount,
+		HasMore:       page.hasMore,
+	}, nil
+}
+
+// ListUserExperiencesV1 returns a page of user experiences sorted by from
+func (r *ExperienceAPI) ListUserExperiencesV1(ctx context.Context, req *desc.ListUserExperiencesV1Request) (*desc.ListUserExperiencesV1Response, error) {
+	log.Printf("ListUserExperiencesV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListUserExperiencesV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.ReadEvent); err != nil {
+		return nil, err
+	}
+
+	filter := models.ExperienceFilter{UserId: req.UserId}
+	order := models.ExperienceOrder{Field: models.OrderByFrom}
+	page, err := r.listPage(ctx, "ListUserExperiencesV1", filter, order, req.PageToken, req.Limit, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*desc.Experience, 0, len(page.experiences))
+	eventMessages := make([]producer.EventMsg, 0, len(page.experiences))
+
+	for _, experience := range page.experiences {
+		result = append(result, models.ConvertExperienceToAPI(&experience))
+		eventMessages = append(eventMessages, producer.NewEvent(ctx, experience.Id, producer.ReadEvent, nil))
+	}
+
+	if req.IncludeTypeName {
+		if err := r.setTypeNames(ctx, result); err != nil {
+			log.Error().
+				Err(err).
+				Str("endpoint", "ListUserExperiencesV1").
+				Msgf("Failed to read experience type names")
+
+			return nil, err
+		}
+	}
+
+	r.producer.Send(eventMessages...)
+	r.metrics.IncList(1, "ListUserExperiencesV1")
+
+	return &desc.ListUserExperiencesV1Response{
+		Experiences:   result,
+		NextPageToken: page.nextPageToken,
+		HasMore:       page.hasMore,
 	}, nil
 }
 
+// ListExperienceV1Stream streams experiences matching filter. Experiences are read by batches of the API batch size
+// and sent one by one, streaming stops when client cancels the request
+func (r *ExperienceAPI) ListExperienceV1Stream(req *desc.ListExperienceV1StreamRequest, stream desc.OcpExperienceApi_ListExperienceV1StreamServer) error {
+	log.Printf("ListExperienceV1Stream request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "ListExperienceV1Stream")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.ReadEvent); err != nil {
+		return err
+	}
+
+	filter := models.ConvertAPIToFilter(req.Filter)
+	order := models.ConvertAPIToOrder(req.OrderBy)
+
+	var after *models.ExperienceCursor
+	var sent uint64 = 0
+
+	for {
+		experiences, err := r.repo.List(ctx, filter, order, after, r.batchSize, 0)
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("endpoint", "ListExperienceV1Stream").
+				Uint64("sent", sent).
+				Msgf("Failed to list experiences")
+
+			r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+			return err
+		}
+
+		for _, experience := range experiences {
+			if err := ctx.Err(); err != nil {
+				return status.FromContextError(err).Err()
+			}
+
+			if err := stream.Send(&desc.ListExperienceV1StreamResponse{
+				Experience: models.ConvertExperienceToAPI(&experience),
+			}); err != nil {
+				return err
+			}
+
+			sent++
+		}
+
+		if len(experiences) == 0 || uint64(len(experiences)) < r.batchSize {
+			break
+		}
+
+		cursor := models.NewCursor(order, experiences[len(experiences)-1])
+		after = &cursor
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, nil))
+	r.metrics.IncList(1, "ListExperienceV1Stream")
+
+	return nil
+}
+
 // DescribeExperienceV1 returns detailed information of an experience
 func (r *ExperienceAPI) DescribeExperienceV1(ctx context.Context, req *desc.DescribeExperienceV1Request) (*desc.DescribeExperienceV1Response, error) {
 	log.Printf("DescribeExperienceV1 request: %v", req)
@@ -118,11 +265,125 @@ func (r *ExperienceAPI) DescribeExperienceV1(ctx context.Context, req *desc.Desc
 		return nil, err
 	}
 
+	result := models.ConvertExperienceToAPI(&experience)
+
+	if req.IncludeTypeName {
+		if err := r.setTypeNames(ctx, []*desc.Experience{result}); err != nil {
+			log.Error().
+				Str("endpoint", "DescribeExperienceV1").
+				Uint64("id", req.Id).
+				Err(err).
+				Msgf("Failed to read experience type name")
+
+			return nil, err
+		}
+	}
+
 	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.ReadEvent, err))
 	r.metrics.IncRead(1, "DescribeExperienceV1")
 
 	return &desc.DescribeExperienceV1Response{
-		Experience: models.ConvertExperienceToAPI(&experience),
+		Experience: result,
+	}, nil
+}
+
+// ListExperienceHistoryV1 returns changes made to an experience
+func (r *ExperienceAPI) ListExperienceHistoryV1(ctx context.Context, req *desc.ListExperienceHistoryV1Request) (*desc.ListExperienceHistoryV1Response, error) {
+	log.Printf("ListExperienceHistoryV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListExperienceHistoryV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.ReadEvent); err != nil {
+		return nil, err
+	}
+
+	records, err := r.repo.ListHistory(ctx, req.Id, req.Limit, req.Offset)
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("endpoint", "ListExperienceHistoryV1").
+			Uint64("id", req.Id).
+			Msgf("Failed to list experience history")
+
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.ReadEvent, err))
+		return nil, err
+	}
+
+	result := make([]*desc.ExperienceHistoryRecord, 0, len(records))
+
+	for _, record := range records {
+		result = append(result, models.ConvertHistoryToAPI(&record))
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.ReadEvent, nil))
+	r.metrics.IncList(1, "ListExperienceHistoryV1")
+
+	return &desc.ListExperienceHistoryV1Response{
+		Records: result,
+	}, nil
+}
+
+// GetUserExperienceSummaryV1 returns user experience duration, maximum level and dates per type
+func (r *ExperienceAPI) GetUserExperienceSummaryV1(ctx context.Context, req *desc.GetUserExperienceSummaryV1Request) (*desc.GetUserExperienceSummaryV1Response, error) {
+	log.Printf("GetUserExperienceSummaryV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GetUserExperienceSummaryV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.ReadEvent); err != nil {
+		return nil, err
+	}
+
+	experiences, err := r.repo.UserExperiences(ctx, req.UserId)
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("endpoint", "GetUserExperienceSummaryV1").
+			Uint64("user_id", req.UserId).
+			Msgf("Failed to list experiences")
+
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+		return nil, err
+	}
+
+	summaries := models.Summarize(experiences, time.Now())
+	result := make([]*desc.ExperienceTypeSummary, 0, len(summaries))
+
+	for _, summary := range summaries {
+		result = append(result, models.ConvertTypeSummaryToAPI(&summary))
+	}
+
+	if req.IncludeTypeName && len(result) > 0 {
+		ids := make([]uint64, 0, len(result))
+
+		for _, summary := range result {
+			ids = append(ids, summary.Type)
+		}
+
+		names, err := r.repo.TypeNames(ctx, ids)
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("endpoint", "GetUserExperienceSummaryV1").
+				Msgf("Failed to read experience type names")
+
+			return nil, err
+		}
+
+		for _, summary := range result {
+			summary.TypeName = names[summary.Type]
+		}
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, nil))
+	r.metrics.IncRead(1, "GetUserExperienceSummaryV1")
+
+	return &desc.GetUserExperienceSummaryV1Response{
+		Types: result,
 	}, nil
 }
 
@@ -137,14 +398,39 @@ func (r *ExperienceAPI) CreateExperienceV1(ctx context.Context, req *desc.Create
 		return nil, err
 	}
 
-	id, err := r.repo.Add(ctx, models.Experience{
-		Id:     0,
-		UserId: req.UserId,
-		Type:   req.Type,
-		From:   req.From.AsTime(),
-		To:     req.To.AsTime(),
-		Level:  req.Level,
-	})
+	experience := createdExperience(req)
+
+	if err := experience.Validate(); err != nil {
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, err))
+		return nil, invalidArgument(err)
+	}
+
+	catalog := newTypeCatalog(r.repo)
+
+	if err := catalog.load(ctx, []models.Experience{experience}); err != nil {
+		return nil, err
+	}
+
+	if violations := catalog.violations(experience, models.ExperienceFields, fieldName); len(violations) > 0 {
+		invalid := &models.ValidationError{Violations: violations}
+
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, invalid))
+		return nil, invalidArgument(invalid)
+	}
+
+	id, mergedIds, err := r.addWithPolicy(ctx, r.repo, experience)
+
+	if errors.Is(err, repository.TypeNotFound) {
+		invalid := unknownTypeError()
+
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, invalid))
+		return nil, invalidArgument(invalid)
+	}
+
+	if status.Code(err) == codes.FailedPrecondition {
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, err))
+		return nil, err
+	}
 
 	if err != nil {
 		log.Error().
@@ -155,45 +441,97 @@ func (r *ExperienceAPI) CreateExperienceV1(ctx context.Context, req *desc.Create
 		return nil, err
 	}
 
-	r.producer.Send(producer.NewEvent(ctx, id, producer.CreateEvent, err))
+	r.sendAdded(ctx, id, mergedIds, err)
 	r.metrics.IncCreate(1, "CreateExperienceV1")
 
 	return &desc.CreateExperienceV1Response{
-		Id: id,
+		Id:        id,
+		MergedIds: mergedIds,
 	}, nil
 }
 
-// MultiCreateExperienceV1  Creates new experiences, returns new ids
+// MultiCreateExperienceV1  Creates new experiences by batches in request mode, returns new ids
 func (r *ExperienceAPI) MultiCreateExperienceV1(ctx context.Context, req *desc.MultiCreateExperienceV1Request) (*desc.MultiCreateExperienceV1Response, error) {
 	log.Printf("Multi create experience: %v", req)
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiCreateExperienceV1")
 	defer span.Finish()
 
-	if err := r.validate(ctx, req, producer.CreateEvent); err != nil {
+	bestEffort := req.Mode == desc.MultiCreateExperienceV1Request_BEST_EFFORT
+	validated := req
+
+	if bestEffort {
+		// experiences are validated one by one to report the invalid ones in their results
+		validated = &desc.MultiCreateExperienceV1Request{Mode: req.Mode, IdempotencyKey: req.IdempotencyKey}
+	}
+
+	if err := r.validate(ctx, validated, producer.CreateEvent); err != nil {
 		return nil, err
 	}
 
 	toCreate := make([]models.Experience, 0, len(req.Experiences))
 
-	for _, experience := range req.Experiences {
-		toCreate = append(toCreate, models.NewExperience(0, experience.UserId, experience.Type, experience.From.AsTime(), experience.To.AsTime(), experience.Level))
+	for _, item := range req.Experiences {
+		toCreate = append(toCreate, createdExperience(item))
 	}
 
-	newIds := make([]uint64, 0, len(req.Experiences))
-	batch, err := utils.SplitExperienceToBulks(toCreate, int(r.batchSize))
+	catalog := newTypeCatalog(r.repo)
+
+	if err := catalog.load(ctx, toCreate); err != nil {
+		return nil, err
+	}
+
+	itemViolations := make([][]models.FieldViolation, 0, len(toCreate))
+
+	for _, experience := range toCreate {
+		itemViolations = append(itemViolations, createViolations(catalog, experience))
+	}
+
+	if bestEffort {
+		itemErrors := make([]error, 0, len(toCreate))
+
+		for index, item := range req.Experiences {
+			itemErrors = append(itemErrors, createError(item, itemViolations[index]))
+		}
+
+		return r.createBestEffort(ctx, toCreate, itemErrors)
+	}
+
+	invalid := &models.ValidationError{}
+
+	for index, violations := range itemViolations {
+		for _, violation := range violations {
+			violation.Field = fmt.Sprintf("experiences[%d].%s", index, violation.Field)
+			invalid.Violations = append(invalid.Violations, violation)
+		}
+	}
+
+	if len(invalid.Violations) > 0 {
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, invalid))
+		return nil, invalidArgument(invalid)
+	}
+
+	batches, err := r.splitBatches(toCreate)
 
 	if err != nil {
 		return nil, err
 	}
 
-	for _, batch := range batch {
-		ids, writeErr := r.writeExperiencesBatch(ctx, batch)
+	if req.Mode == desc.MultiCreateExperienceV1Request_ATOMIC {
+		return r.createAtomic(ctx, batches, len(toCreate))
+	}
+
+	newIds := make([]uint64, 0, len(req.Experiences))
+
+	for _, batch := range batches {
+		ids, mergedIds, writeErr := r.writeExperiencesBatch(ctx, r.repo, batch)
 
 		if writeErr != nil {
+			r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, writeErr))
 			return nil, writeErr
 		}
 
+		r.sendCreated(ctx, ids, mergedIds)
 		newIds = append(newIds, ids...)
 		r.metrics.IncCreate(uint(len(ids)), "MultiCreateExperienceV1")
 	}
@@ -203,100 +541,930 @@ func (r *ExperienceAPI) MultiCreateExperienceV1(ctx context.Context, req *desc.M
 	}, nil
 }
 
-// RemoveExperienceV1 removes experience by id. Returns a removing result
-func (r *ExperienceAPI) RemoveExperienceV1(ctx context.Context, req *desc.RemoveExperienceV1Request) (*desc.RemoveExperienceV1Response, error) {
-	log.Printf("RemoveExperienceV1 request: %v", req)
+// createAtomic creates all batches in a single transaction, nothing is created on error
+func (r *ExperienceAPI) createAtomic(ctx context.Context, batches [][]models.Experience, size int) (*desc.MultiCreateExperienceV1Response, error) {
+	newIds := make([]uint64, 0, size)
+	newMergedIds := make([][]uint64, 0, size)
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveExperienceV1")
-	defer span.Finish()
+	err := r.repo.RunInTx(ctx, func(tx repository.IRepo) error {
+		for _, batch := range batches {
+			ids, mergedIds, writeErr := r.writeExperiencesBatch(ctx, tx, batch)
 
-	if err := r.validate(ctx, req, producer.DeleteEvent); err != nil {
-		return nil, err
-	}
+			if writeErr != nil {
+				return writeErr
+			}
 
-	res, err := r.repo.Remove(ctx, req.Id)
+			newIds = append(newIds, ids...)
+			newMergedIds = append(newMergedIds, mergedIds...)
+		}
 
-	if errors.Is(err, repository.NotFound) {
-		return nil, status.Error(codes.NotFound, "experience does not exist")
-	}
+		return nil
+	})
 
 	if err != nil {
-		log.Error().
-			Err(err).
-			Uint64("id", req.Id).
-			Str("endpoint", "RemoveExperienceV1").
-			Msgf("Failed to remove experience")
-
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, err))
 		return nil, err
 	}
 
-	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.DeleteEvent, err))
-	r.metrics.IncRemove(1, "RemoveExperienceV1")
+	r.sendCreated(ctx, newIds, newMergedIds)
+	r.metrics.IncCreate(uint(len(newIds)), "MultiCreateExperienceV1")
 
-	return &desc.RemoveExperienceV1Response{
-		Removed: res,
+	return &desc.MultiCreateExperienceV1Response{
+		Ids: newIds,
 	}, nil
 }
 
-// UpdateExperienceV1 updates experience
-func (r *ExperienceAPI) UpdateExperienceV1(ctx context.Context, req *desc.UpdateExperienceV1Request) (*desc.UpdateExperienceV1Response, error) {
-	log.Printf("Update request: %v", req)
+// createBestEffort creates valid experiences by batches, experiences of a failed batch are retried one at a time.
+// Returns a result per experience in request order, experiences with item errors are reported as invalid
+func (r *ExperienceAPI) createBestEffort(ctx context.Context,
+	experiences []models.Experience, itemErrors []error) (*desc.MultiCreateExperienceV1Response, error) {
+	results := make([]*desc.ExperienceBatchResult, len(experiences))
+	valid := make([]models.Experience, 0, len(experiences))
+	validIndexes := make([]int, 0, len(experiences))
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateExperienceV1")
-	defer span.Finish()
+	for index, experience := range experiences {
+		if itemErrors[index] != nil {
+			r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, itemErrors[index]))
 
-	if err := r.validate(ctx, req, producer.UpdateEvent); err != nil {
-		return nil, err
-	}
+			results[index] = &desc.ExperienceBatchResult{
+				Status: desc.ExperienceBatchResult_INVALID_ARGUMENT,
+				Error:  itemErrors[index].Error(),
+			}
 
-	err := r.repo.Update(ctx, models.NewExperience(req.Id, req.UserId, req.Type, req.From.AsTime(), req.To.AsTime(), req.Level))
+			continue
+		}
 
-	if errors.Is(err, repository.NotFound) {
-		return nil, status.Error(codes.NotFound, "experience does not exist")
+		valid = append(valid, experience)
+		validIndexes = append(validIndexes, index)
 	}
 
-	if err != nil {
-		log.Error().
-			Uint64("id", req.Id).
-			Str("endpoint", "UpdateExperienceV1").
-			Err(err).
-			Msgf("Failed to update experience")
+	batches, err := r.splitBatches(valid)
 
+	if err != nil {
 		return nil, err
 	}
 
-	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
-	r.metrics.IncUpdate(1, "UpdateExperienceV1")
+	newIds := make([]uint64, 0, len(valid))
+	created := 0
 
-	return &desc.UpdateExperienceV1Response{}, nil
-}
+	for _, batch := range batches {
+		for _, result := range r.createBatchBestEffort(ctx, batch) {
+			if result.Status == desc.ExperienceBatchResult_OK {
+				newIds = append(newIds, result.Id)
+			}
 
-func (r *ExperienceAPI) validate(ctx context.Context, request validator, event producer.EventType) error {
-	if err := request.Validate(); err != nil {
-		r.producer.Send(producer.NewEvent(ctx, 0, event, err))
-		return status.Error(codes.InvalidArgument, err.Error())
+			results[validIndexes[created]] = result
+			created++
+		}
 	}
 
-	return nil
+	r.metrics.IncCreate(uint(len(newIds)), "MultiCreateExperienceV1")
+
+	return &desc.MultiCreateExperienceV1Response{
+		Ids:     newIds,
+		Results: results,
+	}, nil
 }
 
-func (r *ExperienceAPI) writeExperiencesBatch(ctx context.Context, batch []models.Experience) ([]uint64, error) {
-	childSpan, childCtx := opentracing.StartSpanFromContext(ctx, "MultiCreateExperienceV1Batch")
-	childSpan.LogFields(traceLog.Int("batch_size", len(batch)))
-	defer childSpan.Finish()
+// createBatchBestEffort creates batch, experiences of a failed batch are retried one at a time.
+// Returns a result per experience
+func (r *ExperienceAPI) createBatchBestEffort(ctx context.Context, batch []models.Experience) []*desc.ExperienceBatchResult {
+	results := make([]*desc.ExperienceBatchResult, 0, len(batch))
+	ids, mergedIds, writeErr := r.writeExperiencesBatch(ctx, r.repo, batch)
 
-	ids, err := r.repo.AddExperiences(childCtx, batch)
+	if writeErr == nil {
+		for _, id := range ids {
+			results = append(results, &desc.ExperienceBatchResult{Id: id})
+		}
 
-	if err != nil {
-		log.Error().Err(err).Msgf("Failed to save experiences")
-		r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, err))
+		r.sendCreated(ctx, ids, mergedIds)
+		return results
+	}
 
-		return nil, err
+	for _, experience := range batch {
+		id, merged, addErr := r.addWithPolicy(ctx, r.repo, experience)
+		r.sendAdded(ctx, id, merged, addErr)
+
+		if addErr != nil {
+			result := &desc.ExperienceBatchResult{
+				Status: desc.ExperienceBatchResult_ERROR,
+				Error:  addErr.Error(),
+			}
+
+			if status.Code(addErr) == codes.FailedPrecondition {
+				result.Status = desc.ExperienceBatchResult_OVERLAP
+			}
+
+			results = append(results, result)
+			continue
+		}
+
+		results = append(results, &desc.ExperienceBatchResult{Id: id})
+	}
+
+	return results
+}
+
+// ImportExperiencesV1 creates streamed experiences by batches of the API batch size.
+// Invalid and failed experiences are rejected, returns import summary when client closes the stream.
+// The summary counts all rejected experiences and lists reasons of the first maxImportRejections of them
+func (r *ExperienceAPI) ImportExperiencesV1(stream desc.OcpExperienceApi_ImportExperiencesV1Server) error {
+	log.Printf("ImportExperiencesV1 request")
+
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "ImportExperiencesV1")
+	defer span.Finish()
+
+	summary := &desc.ImportExperiencesV1Response{}
+	catalog := newTypeCatalog(r.repo)
+	batch := make([]models.Experience, 0, r.batchSize)
+	batchRows := make([]uint64, 0, r.batchSize)
+	var row uint64 = 0
+
+	// reject counts rejected experience and lists its reason while rejections are below the limit
+	reject := func(row uint64, reason string) {
+		summary.Rejected++
+
+		if len(summary.Rejections) < maxImportRejections {
+			summary.Rejections = append(summary.Rejections, &desc.ImportRejection{
+				Row:    row,
+				Reason: reason,
+			})
+		}
 	}
 
-	for _, id := range ids {
-		r.producer.Send(producer.NewEvent(ctx, id, producer.CreateEvent, nil))
+	// flush creates buffered experiences and adds their results to summary
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		for index, result := range r.createBatchBestEffort(ctx, batch) {
+			if result.Status == desc.ExperienceBatchResult_OK {
+				summary.Accepted++
+				continue
+			}
+
+			reject(batchRows[index], result.Error)
+		}
+
+		batch = batch[:0]
+		batchRows = batchRows[:0]
+	}
+
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("endpoint", "ImportExperiencesV1").
+				Uint64("accepted", summary.Accepted).
+				Msgf("Failed to receive experience")
+
+			return err
+		}
+
+		err = req.Validate()
+
+		if err == nil {
+			err = createdExperience(req.Experience).Validate()
+		}
+
+		if err == nil {
+			experience := createdExperience(req.Experience)
+
+			if err := catalog.load(ctx, []models.Experience{experience}); err != nil {
+				log.Error().
+					Err(err).
+					Str("endpoint", "ImportExperiencesV1").
+					Uint64("accepted", summary.Accepted).
+					Msgf("Failed to read experience type catalog")
+
+				return err
+			}
+
+			if violations := catalog.violations(experience, models.ExperienceFields, fieldName); len(violations) > 0 {
+				err = &models.ValidationError{Violations: violations}
+			}
+		}
+
+		if err != nil {
+			r.producer.Send(producer.NewEvent(ctx, 0, producer.CreateEvent, err))
+
+			reject(row, err.Error())
+		} else {
+			batch = append(batch, createdExperience(req.Experience))
+			batchRows = append(batchRows, row)
+		}
+
+		row++
+
+		if uint64(len(batch)) >= r.batchSize {
+			flush()
+		}
+	}
+
+	flush()
+	r.metrics.IncCreate(uint(summary.Accepted), "ImportExperiencesV1")
+
+	return stream.SendAndClose(summary)
+}
+
+// RemoveExperienceV1 removes experience by id. Returns a removing result
+func (r *ExperienceAPI) RemoveExperienceV1(ctx context.Context, req *desc.RemoveExperienceV1Request) (*desc.RemoveExperienceV1Response, error) {
+	log.Printf("RemoveExperienceV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveExperienceV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.DeleteEvent); err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+
+	if err != nil {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.DeleteEvent, err))
+		return nil, err
+	}
+
+	res, err := r.repo.Remove(ctx, req.Id, version)
+
+	if errors.Is(err, repository.NotFound) {
+		return nil, status.Error(codes.NotFound, "experience does not exist")
+	}
+
+	if errors.Is(err, repository.VersionMismatch) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Uint64("id", req.Id).
+			Str("endpoint", "RemoveExperienceV1").
+			Msgf("Failed to remove experience")
+
+		return nil, err
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.DeleteEvent, err))
+	r.metrics.IncRemove(1, "RemoveExperienceV1")
+
+	return &desc.RemoveExperienceV1Response{
+		Removed: res,
+	}, nil
+}
+
+// RestoreExperienceV1 restores removed experience by id applying the overlap policy. Returns a restoring result
+func (r *ExperienceAPI) RestoreExperienceV1(ctx context.Context, req *desc.RestoreExperienceV1Request) (*desc.RestoreExperienceV1Response, error) {
+	log.Printf("RestoreExperienceV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RestoreExperienceV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.UpdateEvent); err != nil {
+		return nil, err
+	}
+
+	res, mergedIds, err := r.restoreWithPolicy(ctx, req.Id)
+
+	if status.Code(err) == codes.FailedPrecondition {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+		return nil, err
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Uint64("id", req.Id).
+			Str("endpoint", "RestoreExperienceV1").
+			Msgf("Failed to restore experience")
+
+		return nil, err
+	}
+
+	if len(mergedIds) > 0 {
+		r.sendMerged(ctx, req.Id, mergedIds)
+	} else {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+	}
+
+	r.metrics.IncUpdate(1, "RestoreExperienceV1")
+
+	return &desc.RestoreExperienceV1Response{
+		Restored: res,
+	}, nil
+}
+
+// MultiRemoveExperienceV1 removes experiences by batches, every batch is removed in a transaction.
+// Returns a result per experience
+func (r *ExperienceAPI) MultiRemoveExperienceV1(ctx context.Context, req *desc.MultiRemoveExperienceV1Request) (*desc.MultiRemoveExperienceV1Response, error) {
+	log.Printf("Multi remove experience: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiRemoveExperienceV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.DeleteEvent); err != nil {
+		return nil, err
+	}
+
+	toRemove := make([]models.Experience, 0, len(req.Experiences))
+
+	for _, experience := range req.Experiences {
+		toRemove = append(toRemove, models.Experience{Id: experience.Id, Version: experience.ExpectedVersion})
+	}
+
+	results, err := r.changeBatches(ctx, "MultiRemoveExperienceV1", producer.DeleteEvent, toRemove,
+		func(ctx context.Context, batch []models.Experience, _ int) ([]error, error) {
+			return r.repo.RemoveExperiences(ctx, batch)
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.metrics.IncRemove(countSucceeded(results), "MultiRemoveExperienceV1")
+
+	return &desc.MultiRemoveExperienceV1Response{
+		Results: results,
+	}, nil
+}
+
+// MultiUpdateExperienceV1 updates experiences by batches, every batch is updated in a transaction.
+// Returns a result per experience, experiences with invalid update mask are not updated
+func (r *ExperienceAPI) MultiUpdateExperienceV1(ctx context.Context, req *desc.MultiUpdateExperienceV1Request) (*desc.MultiUpdateExperienceV1Response, error) {
+	log.Printf("Multi update experience: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiUpdateExperienceV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.UpdateEvent); err != nil {
+		return nil, err
+	}
+
+	results := make([]*desc.ExperienceBatchResult, len(req.Experiences))
+	toUpdate := make([]models.Experience, 0, len(req.Experiences))
+	toUpdateFields := make([][]string, 0, len(req.Experiences))
+	toUpdateIndexes := make([]int, 0, len(req.Experiences))
+
+	for index, item := range req.Experiences {
+		fields, err := maskFields(item)
+		experience := updatedExperience(item)
+
+		if err == nil {
+			err = experience.ValidateFields(writtenFields(experience, fields))
+		}
+
+		if err != nil {
+			r.producer.Send(producer.NewEvent(ctx, item.Id, producer.UpdateEvent, err))
+			results[index] = &desc.ExperienceBatchResult{
+				Id:     item.Id,
+				Status: desc.ExperienceBatchResult_INVALID_ARGUMENT,
+				Error:  err.Error(),
+			}
+
+			continue
+		}
+
+		experience.Version = item.ExpectedVersion
+
+		toUpdate = append(toUpdate, experience)
+		toUpdateFields = append(toUpdateFields, fields)
+		toUpdateIndexes = append(toUpdateIndexes, index)
+	}
+
+	toUpdate, toUpdateFields, toUpdateIndexes, err := r.skipStoredViolations(ctx, results, toUpdate, toUpdateFields, toUpdateIndexes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(toUpdate) > 0 {
+		updated, err := r.changeBatches(ctx, "MultiUpdateExperienceV1", producer.UpdateEvent, toUpdate,
+			func(ctx context.Context, batch []models.Experience, offset int) ([]error, error) {
+				return r.updateExperiences(ctx, batch, toUpdateFields[offset:offset+len(batch)])
+			},
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for index, result := range updated {
+			results[toUpdateIndexes[index]] = result
+		}
+	}
+
+	r.metrics.IncUpdate(countSucceeded(results), "MultiUpdateExperienceV1")
+
+	return &desc.MultiUpdateExperienceV1Response{
+		Results: results,
+	}, nil
+}
+
+// skipStoredViolations marks updates writing types missing in the type catalog, levels off the type ladder
+// or intervals out of order with stored from or to as invalid, returns the rest of updates
+func (r *ExperienceAPI) skipStoredViolations(
+	ctx context.Context,
+	results []*desc.ExperienceBatchResult,
+	experiences []models.Experience,
+	fields [][]string,
+	indexes []int,
+) ([]models.Experience, [][]string, []int, error) {
+	checked := make([]models.Experience, 0, len(experiences))
+
+	for index, experience := range experiences {
+		experience, err := r.withStoredFields(ctx, experience, writtenFields(experience, fields[index]))
+
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		checked = append(checked, experience)
+	}
+
+	catalog := newTypeCatalog(r.repo)
+
+	if err := catalog.load(ctx, checked); err != nil {
+		return nil, nil, nil, err
+	}
+
+	keptExperiences := make([]models.Experience, 0, len(experiences))
+	keptFields := make([][]string, 0, len(experiences))
+	keptIndexes := make([]int, 0, len(experiences))
+
+	for index, experience := range experiences {
+		written := writtenFields(experience, fields[index])
+		violations := append(intervalViolations(checked[index], written), catalog.violations(checked[index], written, fieldName)...)
+
+		if len(violations) > 0 {
+			err := &models.ValidationError{Violations: violations}
+
+			r.producer.Send(producer.NewEvent(ctx, experience.Id, producer.UpdateEvent, err))
+			results[indexes[index]] = &desc.ExperienceBatchResult{
+				Id:     experience.Id,
+				Status: desc.ExperienceBatchResult_INVALID_ARGUMENT,
+				Error:  err.Error(),
+			}
+
+			continue
+		}
+
+		keptExperiences = append(keptExperiences, experience)
+		keptFields = append(keptFields, fields[index])
+		keptIndexes = append(keptIndexes, indexes[index])
+	}
+
+	return keptExperiences, keptFields, keptIndexes, nil
+}
+
+// UpdateExperienceV1 updates experience
+func (r *ExperienceAPI) UpdateExperienceV1(ctx context.Context, req *desc.UpdateExperienceV1Request) (*desc.UpdateExperienceV1Response, error) {
+	log.Printf("Update request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateExperienceV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.UpdateEvent); err != nil {
+		return nil, err
+	}
+
+	fields, err := r.updateFields(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+
+	if err != nil {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+		return nil, err
+	}
+
+	experience := updatedExperience(req)
+
+	if err := experience.ValidateFields(writtenFields(experience, fields)); err != nil {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+		return nil, invalidArgument(err)
+	}
+
+	written := writtenFields(experience, fields)
+	checked, err := r.withStoredFields(ctx, experience, written)
+
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := newTypeCatalog(r.repo)
+
+	if err := catalog.load(ctx, []models.Experience{checked}); err != nil {
+		return nil, err
+	}
+
+	violations := append(intervalViolations(checked, written), catalog.violations(checked, written, fieldName)...)
+
+	if len(violations) > 0 {
+		invalid := &models.ValidationError{Violations: violations}
+
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, invalid))
+		return nil, invalidArgument(invalid)
+	}
+
+	mergedIds, err := r.updateWithPolicy(ctx, experience, fields, version)
+
+	if errors.Is(err, repository.NotFound) {
+		return nil, status.Error(codes.NotFound, "experience does not exist")
+	}
+
+	if errors.Is(err, repository.VersionMismatch) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if errors.Is(err, repository.TypeNotFound) {
+		invalid := unknownTypeError()
+
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, invalid))
+		return nil, invalidArgument(invalid)
+	}
+
+	if status.Code(err) == codes.FailedPrecondition {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+		return nil, err
+	}
+
+	if err != nil {
+		log.Error().
+			Uint64("id", req.Id).
+			Str("endpoint", "UpdateExperienceV1").
+			Err(err).
+			Msgf("Failed to update experience")
+
+		return nil, err
+	}
+
+	if len(mergedIds) > 0 {
+		r.sendMerged(ctx, req.Id, mergedIds)
+	} else {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+	}
+
+	r.metrics.IncUpdate(1, "UpdateExperienceV1")
+
+	return &desc.UpdateExperienceV1Response{
+		MergedIds: mergedIds,
+	}, nil
+}
+
+func (r *ExperienceAPI) validate(ctx context.Context, request validator, event producer.EventType) error {
+	if err := request.Validate(); err != nil {
+		r.producer.Send(producer.NewEvent(ctx, 0, event, err))
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// updateFields returns experience fields listed in request update mask.
+// Returns InvalidArgument error on unknown paths
+func (r *ExperienceAPI) updateFields(ctx context.Context, req *desc.UpdateExperienceV1Request) ([]string, error) {
+	fields, err := maskFields(req)
+
+	if err != nil {
+		r.producer.Send(producer.NewEvent(ctx, req.Id, producer.UpdateEvent, err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return fields, nil
+}
+
+// changeBatches splits experiences to batches of the API batch size and changes them with change,
+// change gets batch offset in experiences. Returns a result per experience.
+// A failed batch does not stop the next ones, its experiences get ERROR result
+func (r *ExperienceAPI) changeBatches(ctx context.Context,
+	endpoint string,
+	event producer.EventType,
+	experiences []models.Experience,
+	change func(ctx context.Context, batch []models.Experience, offset int) ([]error, error)) ([]*desc.ExperienceBatchResult, error) {
+
+	batches, err := r.splitBatches(experiences)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*desc.ExperienceBatchResult, 0, len(experiences))
+
+	for _, batch := range batches {
+		childSpan, childCtx := opentracing.StartSpanFromContext(ctx, endpoint+"Batch")
+		childSpan.LogFields(traceLog.Int("batch_size", len(batch)))
+
+		errs, changeErr := change(childCtx, batch, len(results))
+		childSpan.Finish()
+
+		if changeErr != nil {
+			log.Error().
+				Err(changeErr).
+				Str("endpoint", endpoint).
+				Int("batch_size", len(batch)).
+				Msgf("Failed to change experiences batch")
+		}
+
+		for index, experience := range batch {
+			result := &desc.ExperienceBatchResult{Id: experience.Id}
+			itemErr := changeErr
+
+			if changeErr == nil {
+				itemErr = errs[index]
+			}
+
+			switch {
+			case changeErr != nil:
+				result.Status = desc.ExperienceBatchResult_ERROR
+			case errors.Is(itemErr, repository.NotFound):
+				result.Status = desc.ExperienceBatchResult_NOT_FOUND
+			case errors.Is(itemErr, repository.VersionMismatch):
+				result.Status = desc.ExperienceBatchResult_VERSION_MISMATCH
+			case status.Code(itemErr) == codes.FailedPrecondition:
+				result.Status = desc.ExperienceBatchResult_OVERLAP
+			case itemErr != nil:
+				result.Status = desc.ExperienceBatchResult_ERROR
+			}
+
+			if itemErr != nil {
+				result.Error = itemErr.Error()
+			}
+
+			r.producer.Send(producer.NewEvent(ctx, experience.Id, event, itemErr))
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// splitBatches splits experiences to batches of the API batch size, a slice shorter than batch size is a single batch.
+// Returns no batches for empty experiences
+func (r *ExperienceAPI) splitBatches(experiences []models.Experience) ([][]models.Experience, error) {
+	if len(experiences) == 0 {
+		return nil, nil
+	}
+
+	if len(experiences) < int(r.batchSize) {
+		return [][]models.Experience{experiences}, nil
+	}
+
+	return utils.SplitExperienceToBulks(experiences, int(r.batchSize))
+}
+
+// maskFields returns experience fields listed in request update mask, returns an error on unknown paths
+func maskFields(req *desc.UpdateExperienceV1Request) ([]string, error) {
+	paths := req.UpdateMask.GetPaths()
+	fields := make([]string, 0, len(paths))
+	seen := make(map[string]bool, len(paths))
+
+	for _, path := range paths {
+		if !models.UpdatableFields[path] {
+			return nil, fmt.Errorf("invalid UpdateExperienceV1Request.UpdateMask: unknown path %q", path)
+		}
+
+		if !seen[path] {
+			seen[path] = true
+			fields = append(fields, path)
+		}
+	}
+
+	if (len(fields) == 0 || seen[models.UserIdField]) && req.UserId == 0 {
+		return nil, errors.New("invalid UpdateExperienceV1Request.UserId: value must be greater than 0")
+	}
+
+	return fields, nil
+}
+
+// createdExperience returns experience written by create request, unset timestamps are zero time
+func createdExperience(req *desc.CreateExperienceV1Request) models.Experience {
+	return models.NewExperience(0, req.UserId, req.Type, asTime(req.From), asTime(req.To), req.Level)
+}
+
+// createViolations returns domain rules and type catalog violations of a created experience
+func createViolations(catalog *typeCatalog, experience models.Experience) []models.FieldViolation {
+	var violations []models.FieldViolation
+	var validationErr *models.ValidationError
+
+	if errors.As(experience.Validate(), &validationErr) {
+		violations = append(violations, validationErr.Violations...)
+	}
+
+	return append(violations, catalog.violations(experience, models.ExperienceFields, fieldName)...)
+}
+
+// createError returns request rules error or violations of a created experience, nil if it's valid
+func createError(req *desc.CreateExperienceV1Request, violations []models.FieldViolation) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if len(violations) > 0 {
+		return &models.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// containsField reports whether field is listed in fields
+func containsField(fields []string, field string) bool {
+	for _, listed := range fields {
+		if listed == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writtenFields returns fields written by update. If fields are empty, non-zero fields are written
+func writtenFields(experience models.Experience, fields []string) []string {
+	if len(fields) > 0 {
+		return fields
+	}
+
+	written := make([]string, 0, len(models.ExperienceFields))
+
+	if experience.Type != 0 {
+		written = append(written, models.TypeField)
+	}
+
+	if !experience.From.IsZero() {
+		written = append(written, models.FromField)
+	}
+
+	if !experience.To.IsZero() {
+		written = append(written, models.ToField)
+	}
+
+	if experience.Level != 0 {
+		written = append(written, models.LevelField)
+	}
+
+	return written
+}
+
+// invalidArgument converts experience validation error to InvalidArgument status with BadRequest details
+func invalidArgument(err error) error {
+	var validationErr *models.ValidationError
+
+	if !errors.As(err, &validationErr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	badRequest := &errdetails.BadRequest{}
+
+	for _, violation := range validationErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return st.Err()
+}
+
+// updatedExperience returns experience written by update request
+func updatedExperience(req *desc.UpdateExperienceV1Request) models.Experience {
+	// unset timestamps are left zero, so they are cleared by masked updates and kept by unmasked ones
+	return models.NewExperience(req.Id, req.UserId, req.Type, asTime(req.From), asTime(req.To), req.Level)
+}
+
+// countSucceeded returns number of OK batch results
+func countSucceeded(results []*desc.ExperienceBatchResult) uint {
+	var count uint = 0
+
+	for _, result := range results {
+		if result.Status == desc.ExperienceBatchResult_OK {
+			count++
+		}
+	}
+
+	return count
+}
+
+// experiencePage is a page of listed experiences
+type experiencePage struct {
+	experiences   []models.Experience
+	nextPageToken string // cursor of the last experience if there are more pages
+	hasMore       bool
+}
+
+// listPage lists a page of experiences matching filter in order after the page token or offset.
+// One extra experience is requested to find out if there are more pages
+func (r *ExperienceAPI) listPage(ctx context.Context, endpoint string, filter models.ExperienceFilter, order models.ExperienceOrder, pageToken string, limit, offset uint64) (experiencePage, error) {
+	after, err := r.pageCursor(ctx, pageToken, offset, order)
+
+	if err != nil {
+		return experiencePage{}, err
+	}
+
+	experiences, err := r.repo.List(ctx, filter, order, after, limit+1, offset)
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("endpoint", endpoint).
+			Uint64("user_id", filter.UserId).
+			Uint64("limit", limit).
+			Uint64("offset", offset).
+			Msgf("Failed to list experiences")
+
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+		return experiencePage{}, err
+	}
+
+	page := experiencePage{experiences: experiences}
+
+	if uint64(len(experiences)) > limit {
+		page.experiences = experiences[:limit]
+		page.nextPageToken = models.NewCursor(order, page.experiences[limit-1]).Encode()
+		page.hasMore = true
+	}
+
+	return page, nil
+}
+
+// pageCursor decodes list request page token, returns nil cursor if token is not set
+func (r *ExperienceAPI) pageCursor(ctx context.Context, pageToken string, offset uint64, order models.ExperienceOrder) (*models.ExperienceCursor, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+
+	if offset != 0 {
+		err := errors.New("offset cannot be used with page_token")
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cursor, err := models.DecodeCursor(pageToken)
+
+	if err == nil && cursor.Order != order {
+		err = errors.New("page_token does not match order_by")
+	}
+
+	if err != nil {
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &cursor, nil
+}
+
+// writeExperiencesBatch adds batch to repo applying the overlap policy, returns new ids
+// and per experience ids of stored experiences it is merged with
+func (r *ExperienceAPI) writeExperiencesBatch(ctx context.Context, repo repository.IRepo, batch []models.Experience) ([]uint64, [][]uint64, error) {
+	childSpan, childCtx := opentracing.StartSpanFromContext(ctx, "MultiCreateExperienceV1Batch")
+	childSpan.LogFields(traceLog.Int("batch_size", len(batch)))
+	defer childSpan.Finish()
+
+	ids, mergedIds, err := r.addExperiences(childCtx, repo, batch)
+
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to save experiences")
+		return nil, nil, err
+	}
+
+	return ids, mergedIds, nil
+}
+
+// sendCreated sends event per experience id, mergedIds are ids of stored experiences merged with experiences
+// of the same index, if any
+func (r *ExperienceAPI) sendCreated(ctx context.Context, ids []uint64, mergedIds [][]uint64) {
+	for index, id := range ids {
+		var merged []uint64
+
+		if index < len(mergedIds) {
+			merged = mergedIds[index]
+		}
+
+		r.sendAdded(ctx, id, merged, nil)
+	}
+}
+
+// asTime converts timestamp to time, nil timestamp is converted to zero time
+func asTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
 	}
 
-	return ids, nil
+	return timestamp.AsTime()
 }
@@ -2,7 +2,12 @@ package api_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 
@@ -14,18 +19,64 @@ import (
 	"github.com/ozoncp/ocp-experience-api/internal/api"
 	"github.com/ozoncp/ocp-experience-api/internal/mocks/mocks"
 	"github.com/ozoncp/ocp-experience-api/internal/models"
+	"github.com/ozoncp/ocp-experience-api/internal/producer"
 	"github.com/ozoncp/ocp-experience-api/internal/repo"
 
 	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// experience interval passing domain validation
+var (
+	validFrom = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	validTo   = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// unknownType is an experience type missing in the type catalog
+const unknownType = 999
+
+// typeCatalog returns names of catalog types, all types but unknownType are known
+func typeCatalog(_ context.Context, ids []uint64) (map[uint64]string, error) {
+	names := make(map[uint64]string, len(ids))
+
+	for _, id := range ids {
+		if id != unknownType {
+			names[id] = fmt.Sprintf("type %d", id)
+		}
+	}
+
+	return names, nil
+}
+
+// ladderType is an experience type with the level ladder from 1 to 4
+const ladderType = 7
+
+// typeLadders returns level ladders of catalog types, only ladderType has a ladder
+func typeLadders(_ context.Context, ids []uint64) (map[uint64]models.Ladder, error) {
+	ladders := make(map[uint64]models.Ladder)
+
+	for _, id := range ids {
+		if id == ladderType {
+			ladders[id] = models.Ladder{
+				{TypeId: id, Level: 1, Name: "junior"},
+				{TypeId: id, Level: 2, Name: "middle", MinDuration: 365 * 24 * time.Hour},
+				{TypeId: id, Level: 3, Name: "senior", MinDuration: 3 * 365 * 24 * time.Hour},
+				{TypeId: id, Level: 4, Name: "lead", MinDuration: 5 * 365 * 24 * time.Hour},
+			}
+		}
+	}
+
+	return ladders, nil
+}
+
 var _ = Describe("Api", func() {
 	var (
 		experienceAPI 	*api.ExperienceAPI
-		mockRepo      	*mocks.MockRepo
+		mockRepo      	*mocks.MockIRepo
 		mockCtrl   		*gomock.Controller
 		ctx        		context.Context
 		mockProm     	*mocks.MockReporter
@@ -34,7 +85,7 @@ var _ = Describe("Api", func() {
 
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
-		mockRepo = mocks.NewMockRepo(mockCtrl)
+		mockRepo = mocks.NewMockIRepo(mockCtrl)
 		mockProm = mocks.NewMockReporter(mockCtrl)
 		mockProducer = mocks.NewMockProducer(mockCtrl)
 		ctx = context.Background()
@@ -52,8 +103,19 @@ var _ = Describe("Api", func() {
 				mockProm,
 				mockProducer,
 				opentracing.NoopTracer{},
+				api.OverlapAllow,
 			)
 			ctx = context.Background()
+
+			mockRepo.EXPECT().
+				TypeNames(gomock.Any(), gomock.Any()).
+				DoAndReturn(typeCatalog).
+				AnyTimes()
+
+			mockRepo.EXPECT().
+				Ladders(gomock.Any(), gomock.Any()).
+				DoAndReturn(typeLadders).
+				AnyTimes()
 		})
 
 		It("Add with no error", func() {
@@ -75,8 +137,8 @@ var _ = Describe("Api", func() {
 				ctx, &desc.CreateExperienceV1Request{
 					UserId: 1,
 					Type:   1,
-					From:   nil,
-					To:     nil,
+					From:   timestamppb.New(validFrom),
+					To:     timestamppb.New(validTo),
 					Level:  1,
 				},
 			)
@@ -89,11 +151,65 @@ var _ = Describe("Api", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("Add ongoing experience", func() {
+			mockRepo.EXPECT().
+				Add(gomock.Any(), models.NewExperience(0, 1, 1, validFrom, time.Time{}, 1)).
+				Return(uint64(12), nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(1), "CreateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   1,
+					From:   timestamppb.New(validFrom),
+					Level:  1,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Id).To(Equal(uint64(12)))
+		})
+
+		It("Add experience of a large catalog type id and level", func() {
+			mockRepo.EXPECT().
+				Add(gomock.Any(), models.NewExperience(0, 1, 5000, validFrom, time.Time{}, 500)).
+				Return(uint64(13), nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(1), "CreateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   5000,
+					From:   timestamppb.New(validFrom),
+					Level:  500,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Id).To(Equal(uint64(13)))
+		})
+
 		It("Add slice experience with no error", func() {
 			experiences := []models.Experience{
-				models.NewExperience(0, 1, 1, time.Time{}, time.Time{}, 1),
-				models.NewExperience(0, 2, 2, time.Time{}, time.Time{}, 2),
-				models.NewExperience(0, 3, 3, time.Time{}, time.Time{}, 3),
+				models.NewExperience(0, 1, 1, validFrom, validTo, 1),
+				models.NewExperience(0, 2, 2, validFrom, validTo, 2),
+				models.NewExperience(0, 3, 3, validFrom, validTo, 3),
 			}
 
 			createExperienceV1Requests := make([]*desc.CreateExperienceV1Request, 0)
@@ -144,191 +260,1446 @@ var _ = Describe("Api", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		It("Add() params validation", func() {
-			mockProducer.EXPECT().
-				Send(gomock.Any()).
+		It("Add slice experience in a transaction", func() {
+			experiences := []models.Experience{
+				models.NewExperience(0, 1, 1, validFrom, validTo, 1),
+				models.NewExperience(0, 2, 2, validFrom, validTo, 2),
+				models.NewExperience(0, 3, 3, validFrom, validTo, 3),
+			}
+
+			requests := make([]*desc.CreateExperienceV1Request, 0, len(experiences))
+
+			for _, r := range experiences {
+				requests = append(requests, &desc.CreateExperienceV1Request{
+					UserId: r.UserId,
+					Type:   r.Type,
+					From:   timestamppb.New(r.From),
+					To:     timestamppb.New(r.To),
+					Level:  r.Level,
+				})
+			}
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
 				Times(1)
 
-			_, err := experienceAPI.CreateExperienceV1(
-				ctx, &desc.CreateExperienceV1Request{UserId: 0},
-			)
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), experiences[:2]).
+				Return([]uint64{1, 2}, nil).
+				Times(1)
 
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid CreateExperienceV1Request.UserId: value must be greater than 0"))
-		})
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), experiences[2:]).
+				Return([]uint64{3}, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(3), "MultiCreateExperienceV1").
+				Times(1)
 
-		It("Remove() params validation", func() {
 			mockProducer.EXPECT().
 				Send(gomock.Any()).
-				Times(1)
+				Times(3)
 
-			_, err := experienceAPI.RemoveExperienceV1(
-				ctx, &desc.RemoveExperienceV1Request{},
+			resp, err := experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: requests,
+					Mode:        desc.MultiCreateExperienceV1Request_ATOMIC,
+				},
 			)
 
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid RemoveExperienceV1Request.Id: value must be greater than 0"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(&desc.MultiCreateExperienceV1Response{
+				Ids: []uint64{1, 2, 3},
+			}))
 		})
 
-		It("Describe() params validation", func() {
+		It("Add slice experience in a failed transaction", func() {
+			expectedError := errors.New("test error")
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Any()).
+				Return([]uint64{1, 2}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Any()).
+				Return(nil, expectedError).
+				Times(1)
+
 			mockProducer.EXPECT().
 				Send(gomock.Any()).
 				Times(1)
 
-			_, err := experienceAPI.DescribeExperienceV1(
-				ctx, &desc.DescribeExperienceV1Request{},
+			resp, err := experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+						{UserId: 2, Type: 2, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 2},
+						{UserId: 3, Type: 3, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 3},
+					},
+					Mode: desc.MultiCreateExperienceV1Request_ATOMIC,
+				},
 			)
 
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid DescribeExperienceV1Request.Id: value must be greater than 0"))
+			Expect(err).To(Equal(expectedError))
+			Expect(resp).To(BeNil())
 		})
 
-		It("List() params validation", func() {
-			mockProducer.EXPECT().
-				Send(gomock.Any()).
-				Times(2)
+		It("Add slice experience with best effort", func() {
+			expectedError := errors.New("test error")
 
-			_, err := experienceAPI.ListExperienceV1(
-				ctx, &desc.ListExperienceV1Request{Limit: 0},
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Any()).
+				Return(nil, expectedError).
+				Times(1)
+
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Any()).
+				Return([]uint64{3}, nil).
+				Times(1)
+
+			gomock.InOrder(
+				mockRepo.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil),
+				mockRepo.EXPECT().
+					Add(gomock.Any(), gomock.Any()).
+					Return(uint64(0), expectedError),
 			)
 
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid ListExperienceV1Request.Limit: value must be inside range (0, 10000]"))
+			mockProm.EXPECT().
+				IncCreate(uint(2), "MultiCreateExperienceV1").
+				Times(1)
 
-			_, err = experienceAPI.ListExperienceV1(
-				ctx, &desc.ListExperienceV1Request{Limit: 1000000},
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			resp, err := experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+						{UserId: 2, Type: 2, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 2},
+						{UserId: 3, Type: 3, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 3},
+					},
+					Mode: desc.MultiCreateExperienceV1Request_BEST_EFFORT,
+				},
 			)
 
-			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid ListExperienceV1Request.Limit: value must be inside range (0, 10000]"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(&desc.MultiCreateExperienceV1Response{
+				Ids: []uint64{1, 3},
+				Results: []*desc.ExperienceBatchResult{
+					{Id: 1},
+					{Status: desc.ExperienceBatchResult_ERROR, Error: expectedError.Error()},
+					{Id: 3},
+				},
+			}))
 		})
 
-		It("List experience with no error", func() {
-			offset, limit := uint64(10), uint64(100)
-			requests := []models.Experience{
-				models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1),
-				models.NewExperience(2, 2, 2, time.Time{}, time.Time{}, 2),
-				models.NewExperience(3, 3, 3, time.Time{}, time.Time{}, 3),
-			}
-
-			mockProm.EXPECT().
-				IncList(uint(1), "ListExperienceV1").
+		It("Add slice experience with best effort and an invalid item", func() {
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Len(2)).
+				Return([]uint64{1, 3}, nil).
 				Times(1)
 
-			mockRepo.EXPECT().
-				List(gomock.Any(), limit, offset).
-				Return(requests, nil).
+			mockProm.EXPECT().
+				IncCreate(uint(2), "MultiCreateExperienceV1").
 				Times(1)
 
 			mockProducer.EXPECT().
 				Send(gomock.Any()).
 				Times(3)
 
-			resp, err := experienceAPI.ListExperienceV1(
-				ctx, &desc.ListExperienceV1Request{
-					Offset: offset, Limit: limit,
+			resp, err := experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+						{UserId: 0, Type: 2, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 2},
+						{UserId: 3, Type: 3, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 3},
+					},
+					Mode: desc.MultiCreateExperienceV1Request_BEST_EFFORT,
 				},
 			)
 
-			req := make([]*desc.Experience, 0, len(requests))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Ids).To(Equal([]uint64{1, 3}))
+			Expect(resp.Results).To(HaveLen(3))
+			Expect(resp.Results[0]).To(Equal(&desc.ExperienceBatchResult{Id: 1}))
+			Expect(resp.Results[1].Status).To(Equal(desc.ExperienceBatchResult_INVALID_ARGUMENT))
+			Expect(resp.Results[1].Error).To(ContainSubstring("UserId"))
+			Expect(resp.Results[2]).To(Equal(&desc.ExperienceBatchResult{Id: 3}))
+		})
 
-			for _, r := range requests {
-				req = append(req, models.ConvertExperienceToAPI(&r))
+		It("Import streamed experiences", func() {
+			stream := mocks.NewMockOcpExperienceApi_ImportExperiencesV1Server(mockCtrl)
+			expectedError := errors.New("test error")
+			requests := []*desc.ImportExperiencesV1Request{
+				{Experience: &desc.CreateExperienceV1Request{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1}},
+				{Experience: &desc.CreateExperienceV1Request{UserId: 0, Type: 2, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 2}},
+				{Experience: &desc.CreateExperienceV1Request{UserId: 3, Type: 3, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 3}},
+				{},
+				{Experience: &desc.CreateExperienceV1Request{UserId: 5, Type: 5, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 5}},
 			}
 
-			Expect(resp).
-				To(Equal(&desc.ListExperienceV1Response{
-					Experiences: req,
-				}))
+			stream.EXPECT().
+				Context().
+				Return(ctx).
+				AnyTimes()
+
+			calls := make([]*gomock.Call, 0, len(requests)+1)
+
+			for _, req := range requests {
+				calls = append(calls, stream.EXPECT().Recv().Return(req, nil))
+			}
+
+			calls = append(calls, stream.EXPECT().Recv().Return(nil, io.EOF))
+			gomock.InOrder(calls...)
+
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Len(2)).
+				Return([]uint64{1, 3}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), gomock.Len(1)).
+				Return(nil, expectedError).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Add(gomock.Any(), gomock.Any()).
+				Return(uint64(0), expectedError).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(2), "ImportExperiencesV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(5)
+
+			stream.EXPECT().
+				SendAndClose(gomock.Any()).
+				DoAndReturn(func(summary *desc.ImportExperiencesV1Response) error {
+					Expect(summary.Accepted).To(Equal(uint64(2)))
+					Expect(summary.Rejected).To(Equal(uint64(3)))
+					Expect(summary.Rejections).To(HaveLen(3))
+					Expect(summary.Rejections[0].Row).To(Equal(uint64(1)))
+					Expect(summary.Rejections[1].Row).To(Equal(uint64(3)))
+					Expect(summary.Rejections[2]).To(Equal(&desc.ImportRejection{Row: 4, Reason: expectedError.Error()}))
+
+					return nil
+				}).
+				Times(1)
+
+			err := experienceAPI.ImportExperiencesV1(stream)
 
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		removeTest := func(expectFound bool) {
-			id := uint64(11)
+		It("Import lists limited number of rejections", func() {
+			stream := mocks.NewMockOcpExperienceApi_ImportExperiencesV1Server(mockCtrl)
+			limit := 100
+			rejected := limit + 5
 
-			if expectFound {
-				mockRepo.EXPECT().
-					Remove(gomock.Any(), id).
-					Return(true, nil).
-					Times(1)
-			} else {
-				mockRepo.EXPECT().
-					Remove(gomock.Any(), id).
-					Return(false, nil).
-					Times(1)
+			stream.EXPECT().
+				Context().
+				Return(ctx).
+				AnyTimes()
+
+			calls := make([]*gomock.Call, 0, rejected+1)
+
+			for index := 0; index < rejected; index++ {
+				calls = append(calls, stream.EXPECT().Recv().Return(&desc.ImportExperiencesV1Request{}, nil))
 			}
 
+			calls = append(calls, stream.EXPECT().Recv().Return(nil, io.EOF))
+			gomock.InOrder(calls...)
+
 			mockProm.EXPECT().
-				IncRemove(uint(1), "RemoveExperienceV1").
+				IncCreate(uint(0), "ImportExperiencesV1").
 				Times(1)
 
 			mockProducer.EXPECT().
 				Send(gomock.Any()).
+				Times(rejected)
+
+			stream.EXPECT().
+				SendAndClose(gomock.Any()).
+				DoAndReturn(func(summary *desc.ImportExperiencesV1Response) error {
+					Expect(summary.Accepted).To(Equal(uint64(0)))
+					Expect(summary.Rejected).To(Equal(uint64(rejected)))
+					Expect(summary.Rejections).To(HaveLen(limit))
+					Expect(summary.Rejections[limit-1].Row).To(Equal(uint64(limit - 1)))
+
+					return nil
+				}).
 				Times(1)
 
-			resp, err := experienceAPI.RemoveExperienceV1(
-				ctx, &desc.RemoveExperienceV1Request{
-					Id: id,
-				},
-			)
-
-			Expect(resp).To(Equal(&desc.RemoveExperienceV1Response{
-					Removed: expectFound,
-				}))
+			err := experienceAPI.ImportExperiencesV1(stream)
 
 			Expect(err).ToNot(HaveOccurred())
-		}
-
-
-		It("Remove existing experience with no errors", func() {
-			removeTest(true)
 		})
 
-		It("Remove non-existing experience with no errors", func() {
-			removeTest(false)
-		})
+		It("Remove experiences by batches", func() {
+			batchErr := errors.New("test error")
 
-		It("Update existing experience", func() {
-			req := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
 			mockRepo.EXPECT().
-				Update(gomock.Any(), req).
-				Return(nil).
+				RemoveExperiences(gomock.Any(), []models.Experience{{Id: 1}, {Id: 2, Version: 3}}).
+				Return([]error{nil, repo.VersionMismatch}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				RemoveExperiences(gomock.Any(), []models.Experience{{Id: 4}}).
+				Return(nil, batchErr).
 				Times(1)
 
 			mockProm.EXPECT().
-				IncUpdate(uint(1), "UpdateExperienceV1").
+				IncRemove(uint(1), "MultiRemoveExperienceV1").
 				Times(1)
 
 			mockProducer.EXPECT().
 				Send(gomock.Any()).
-				Times(1)
+				Times(3)
 
-			resp, err := experienceAPI.UpdateExperienceV1(
-				ctx, &desc.UpdateExperienceV1Request{
-					Id: req.Id,
-					UserId:    	req.UserId,
-					Type:      	req.Type,
-					From:      	timestamppb.New(req.From),
-					To: 		timestamppb.New(req.To),
-					Level: 		req.Level,
+			resp, err := experienceAPI.MultiRemoveExperienceV1(
+				ctx, &desc.MultiRemoveExperienceV1Request{
+					Experiences: []*desc.RemoveExperienceV1Request{
+						{Id: 1},
+						{Id: 2, ExpectedVersion: 3},
+						{Id: 4},
+					},
 				},
 			)
 
-			Expect(resp).To(Equal(&desc.UpdateExperienceV1Response{}))
 			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(&desc.MultiRemoveExperienceV1Response{
+				Results: []*desc.ExperienceBatchResult{
+					{Id: 1, Status: desc.ExperienceBatchResult_OK},
+					{Id: 2, Status: desc.ExperienceBatchResult_VERSION_MISMATCH, Error: repo.VersionMismatch.Error()},
+					{Id: 4, Status: desc.ExperienceBatchResult_ERROR, Error: batchErr.Error()},
+				},
+			}))
 		})
 
-		It("Describe existing experience", func() {
-			experience := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
+		It("Update experiences by batches", func() {
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), uint64(3)).
+				Return(models.Experience{}, repo.NotFound).
+				Times(1)
 
 			mockRepo.EXPECT().
-				Describe(gomock.Any(), experience.Id).
-				Return(experience, nil).
+				UpdateExperiences(
+					gomock.Any(),
+					[]models.Experience{
+						models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1),
+						{Id: 3, Level: 2},
+					},
+					[][]string{{}, {models.LevelField}},
+				).
+				Return([]error{nil, repo.NotFound}, nil).
 				Times(1)
 
 			mockProm.EXPECT().
-				IncRead(uint(1), "DescribeExperienceV1").
+				IncUpdate(uint(1), "MultiUpdateExperienceV1").
 				Times(1)
 
 			mockProducer.EXPECT().
 				Send(gomock.Any()).
-				Times(1)
+				Times(3)
+
+			resp, err := experienceAPI.MultiUpdateExperienceV1(
+				ctx, &desc.MultiUpdateExperienceV1Request{
+					Experiences: []*desc.UpdateExperienceV1Request{
+						{
+							Id:     1,
+							UserId: 1,
+							Type:   1,
+							From:   timestamppb.New(time.Time{}),
+							To:     timestamppb.New(time.Time{}),
+							Level:  1,
+						},
+						{
+							Id:         2,
+							UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+						},
+						{
+							Id:         3,
+							Level:      2,
+							UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.LevelField}},
+						},
+					},
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Results).To(HaveLen(3))
+			Expect(resp.Results[0]).To(Equal(&desc.ExperienceBatchResult{Id: 1, Status: desc.ExperienceBatchResult_OK}))
+			Expect(resp.Results[1].Status).To(Equal(desc.ExperienceBatchResult_INVALID_ARGUMENT))
+			Expect(resp.Results[2]).To(Equal(&desc.ExperienceBatchResult{
+				Id:     3,
+				Status: desc.ExperienceBatchResult_NOT_FOUND,
+				Error:  repo.NotFound.Error(),
+			}))
+		})
+
+		It("Add() params validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{UserId: 0},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid CreateExperienceV1Request.UserId: value must be greater than 0"))
+		})
+
+		It("Add() experience domain validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(2)
+
+			_, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   1,
+					From:   timestamppb.New(validTo),
+					To:     timestamppb.New(validFrom),
+					Level:  0,
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(status.Convert(err).Details()).To(HaveLen(1))
+
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(HaveLen(2))
+			Expect(badRequest.FieldViolations[0].Field).To(Equal(models.LevelField))
+			Expect(badRequest.FieldViolations[1].Field).To(Equal(models.ToField))
+			Expect(badRequest.FieldViolations[1].Description).To(Equal("must be after from"))
+
+			_, err = experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+						{UserId: 2, Type: 2, To: timestamppb.New(validTo), Level: 2},
+					},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok = status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: "experiences[1].from", Description: "is required"},
+			}))
+		})
+
+		It("Update() experience domain validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					From:       timestamppb.New(time.Now().Add(time.Hour)),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.FromField}},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: models.FromField, Description: "must not be in the future"},
+			}))
+		})
+
+		It("Reject update intervals out of order with stored from or to", func() {
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), uint64(1)).
+				Return(models.NewExperience(1, 1, 1, validFrom, validTo, 1), nil).
+				Times(4)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(0), "MultiUpdateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(4)
+
+			_, err := experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					To:         timestamppb.New(validFrom.AddDate(0, 0, -1)),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.ToField}},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: models.ToField, Description: "must be after from"},
+			}))
+
+			_, err = experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					From:       timestamppb.New(validTo.AddDate(0, 0, 1)),
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.FromField}},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok = status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: models.FromField, Description: "must be before to"},
+			}))
+
+			resp, err := experienceAPI.MultiUpdateExperienceV1(
+				ctx, &desc.MultiUpdateExperienceV1Request{
+					Experiences: []*desc.UpdateExperienceV1Request{
+						{
+							Id:         1,
+							To:         timestamppb.New(validFrom.AddDate(0, 0, -1)),
+							UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.ToField}},
+						},
+						{
+							Id:         1,
+							From:       timestamppb.New(validTo.AddDate(0, 0, 1)),
+							UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.FromField}},
+						},
+					},
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Results).To(HaveLen(2))
+			Expect(resp.Results[0].Status).To(Equal(desc.ExperienceBatchResult_INVALID_ARGUMENT))
+			Expect(resp.Results[0].Error).To(ContainSubstring("to: must be after from"))
+			Expect(resp.Results[1].Status).To(Equal(desc.ExperienceBatchResult_INVALID_ARGUMENT))
+			Expect(resp.Results[1].Error).To(ContainSubstring("from: must be before to"))
+		})
+
+		It("Reject experience types missing in the type catalog", func() {
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), uint64(1)).
+				Return(models.NewExperience(1, 1, 1, validFrom, time.Time{}, 1), nil).
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			_, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   unknownType,
+					From:   timestamppb.New(validFrom),
+					Level:  1,
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: models.TypeField, Description: "unknown experience type"},
+			}))
+
+			_, err = experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), Level: 1},
+						{UserId: 2, Type: unknownType, From: timestamppb.New(validFrom), Level: 2},
+					},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok = status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: "experiences[1].type", Description: "unknown experience type"},
+			}))
+
+			_, err = experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					Type:       unknownType,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.TypeField}},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("Reject experience of type removed from the catalog while it is written", func() {
+			mockRepo.EXPECT().
+				Add(gomock.Any(), gomock.Any()).
+				Return(uint64(0), repo.TypeNotFound).
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   1,
+					From:   timestamppb.New(validFrom),
+					Level:  1,
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: models.TypeField, Description: "unknown experience type"},
+			}))
+		})
+
+		It("Reject levels off the experience type ladder", func() {
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), uint64(1)).
+				Return(models.NewExperience(1, 1, ladderType, validFrom, time.Time{}, 1), nil).
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(2)
+
+			_, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   ladderType,
+					From:   timestamppb.New(validFrom),
+					Level:  5,
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+
+			Expect(ok).To(BeTrue())
+			Expect(badRequest.FieldViolations).To(Equal([]*errdetails.BadRequest_FieldViolation{
+				{Field: models.LevelField, Description: "must be on the experience type ladder"},
+			}))
+
+			_, err = experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					Level:      10,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{models.LevelField}},
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("Set experience type level ladder", func() {
+			ladder := models.Ladder{
+				{TypeId: ladderType, Level: 1, Name: "junior"},
+				{TypeId: ladderType, Level: 2, Name: "middle", MinDuration: time.Hour},
+			}
+
+			mockRepo.EXPECT().
+				SetLadder(gomock.Any(), uint64(ladderType), ladder).
+				Return(nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "SetExperienceLevelsV1").
+				Times(1)
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListExperienceLevelsV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			_, err := experienceAPI.SetExperienceLevelsV1(ctx, &desc.SetExperienceLevelsV1Request{
+				TypeId: ladderType,
+				Levels: []*desc.ExperienceLevel{
+					models.ConvertExperienceLevelToAPI(&ladder[0]),
+					models.ConvertExperienceLevelToAPI(&ladder[1]),
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = experienceAPI.SetExperienceLevelsV1(ctx, &desc.SetExperienceLevelsV1Request{
+				TypeId: ladderType,
+				Levels: []*desc.ExperienceLevel{
+					{Level: 1, Name: "junior"},
+					{Level: 1, Name: "middle"},
+				},
+			})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			resp, err := experienceAPI.ListExperienceLevelsV1(ctx, &desc.ListExperienceLevelsV1Request{TypeId: ladderType})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Levels).To(HaveLen(4))
+			Expect(resp.Levels[2].Name).To(Equal("senior"))
+		})
+
+		It("Manage experience type catalog", func() {
+			mockRepo.EXPECT().
+				AddType(gomock.Any(), models.ExperienceType{Name: "golang"}).
+				Return(uint64(0), repo.TypeExists).
+				Times(1)
+
+			mockRepo.EXPECT().
+				UpdateType(gomock.Any(), models.ExperienceType{Id: 1, Name: "go"}).
+				Return(nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				RemoveType(gomock.Any(), uint64(1)).
+				Return(false, repo.TypeInUse).
+				Times(1)
+
+			mockRepo.EXPECT().
+				DescribeType(gomock.Any(), uint64(2)).
+				Return(models.ExperienceType{}, repo.TypeNotFound).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "UpdateExperienceTypeV1").
+				Times(1)
+
+			events := make([]*desc.ExperienceAPIEvent, 0, 5)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				DoAndReturn(func(messages ...producer.EventMsg) {
+					for _, message := range messages {
+						data, err := message.Encode()
+						Expect(err).ToNot(HaveOccurred())
+
+						event := &desc.ExperienceAPIEvent{}
+						Expect(proto.Unmarshal(data, event)).To(Succeed())
+
+						events = append(events, event)
+					}
+				}).
+				Times(5)
+
+			_, err := experienceAPI.CreateExperienceTypeV1(ctx, &desc.CreateExperienceTypeV1Request{Name: "golang"})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+			_, err = experienceAPI.CreateExperienceTypeV1(ctx, &desc.CreateExperienceTypeV1Request{})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			_, err = experienceAPI.UpdateExperienceTypeV1(ctx, &desc.UpdateExperienceTypeV1Request{Id: 1, Name: "go"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = experienceAPI.RemoveExperienceTypeV1(ctx, &desc.RemoveExperienceTypeV1Request{Id: 1})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			_, err = experienceAPI.DescribeExperienceTypeV1(ctx, &desc.DescribeExperienceTypeV1Request{Id: 2})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+			Expect(events).To(HaveLen(5))
+
+			for _, event := range events {
+				Expect(event.Entity).To(Equal(desc.ExperienceAPIEvent_EXPERIENCE_TYPE))
+			}
+
+			Expect(events[2].Id).To(Equal(uint64(1)))
+			Expect(events[2].Event).To(Equal(desc.ExperienceAPIEvent_UPDATE))
+			Expect(events[2].Error).To(BeEmpty())
+			Expect(events[3].Event).To(Equal(desc.ExperienceAPIEvent_DELETE))
+			Expect(events[3].Error).To(Equal(repo.TypeInUse.Error()))
+		})
+
+		It("Remove() params validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.RemoveExperienceV1(
+				ctx, &desc.RemoveExperienceV1Request{},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid RemoveExperienceV1Request.Id: value must be greater than 0"))
+		})
+
+		It("Describe() params validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.DescribeExperienceV1(
+				ctx, &desc.DescribeExperienceV1Request{},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid DescribeExperienceV1Request.Id: value must be greater than 0"))
+		})
+
+		It("List() params validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(2)
+
+			_, err := experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{Limit: 0},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid ListExperienceV1Request.Limit: value must be inside range (0, 10000]"))
+
+			_, err = experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{Limit: 1000000},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid ListExperienceV1Request.Limit: value must be inside range (0, 10000]"))
+		})
+
+		It("List experience with no error", func() {
+			offset, limit := uint64(10), uint64(100)
+			requests := []models.Experience{
+				models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1),
+				models.NewExperience(2, 2, 2, time.Time{}, time.Time{}, 2),
+				models.NewExperience(3, 3, 3, time.Time{}, time.Time{}, 3),
+			}
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListExperienceV1").
+				Times(1)
+
+			mockRepo.EXPECT().
+				List(gomock.Any(), models.ExperienceFilter{}, models.ExperienceOrder{}, gomock.Nil(), limit+1, offset).
+				Return(requests, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Count(gomock.Any(), models.ExperienceFilter{}).
+				Return(uint64(13), nil).
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			resp, err := experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{
+					Offset: offset, Limit: limit,
+				},
+			)
+
+			req := make([]*desc.Experience, 0, len(requests))
+
+			for _, r := range requests {
+				req = append(req, models.ConvertExperienceToAPI(&r))
+			}
+
+			Expect(resp).
+				To(Equal(&desc.ListExperienceV1Response{
+					Experiences: req,
+					TotalCount:  13,
+				}))
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("List experience with filter and order", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListExperienceV1").
+				Times(1)
+
+			mockRepo.EXPECT().
+				List(
+					gomock.Any(),
+					models.ExperienceFilter{UserId: 1, Types: []uint64{2, 3}, MinLevel: 1, From: from},
+					models.ExperienceOrder{Field: models.OrderByLevel, Desc: true},
+					gomock.Nil(),
+					uint64(11),
+					uint64(0),
+				).
+				Return([]models.Experience{}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Count(gomock.Any(), models.ExperienceFilter{UserId: 1, Types: []uint64{2, 3}, MinLevel: 1, From: from}).
+				Return(uint64(0), nil).
+				Times(1)
+
+			resp, err := experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{
+					Limit: 10,
+					Filter: &desc.ExperienceFilter{
+						UserId:   1,
+						Types:    []uint64{2, 3},
+						MinLevel: 1,
+						From:     timestamppb.New(from),
+					},
+					OrderBy: &desc.ExperienceOrder{
+						Field: desc.ExperienceOrder_LEVEL,
+						Desc:  true,
+					},
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Experiences).To(BeEmpty())
+		})
+
+		It("List experience pages by page token", func() {
+			order := models.ExperienceOrder{Field: models.OrderByFrom}
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			firstPage := []models.Experience{
+				models.NewExperience(1, 1, 1, from, time.Time{}, 1),
+				models.NewExperience(2, 1, 1, from.AddDate(1, 0, 0), time.Time{}, 1),
+				models.NewExperience(3, 1, 1, from.AddDate(2, 0, 0), time.Time{}, 1),
+			}
+
+			expectedCursor := models.NewCursor(order, firstPage[1])
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListExperienceV1").
+				Times(2)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				AnyTimes()
+
+			gomock.InOrder(
+				mockRepo.EXPECT().
+					List(gomock.Any(), models.ExperienceFilter{}, order, gomock.Nil(), uint64(3), uint64(0)).
+					Return(firstPage, nil),
+				mockRepo.EXPECT().
+					List(gomock.Any(), models.ExperienceFilter{}, order, &expectedCursor, uint64(3), uint64(0)).
+					Return(firstPage[2:], nil),
+			)
+
+			resp, err := experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{
+					Limit:          2,
+					OrderBy:        &desc.ExperienceOrder{Field: desc.ExperienceOrder_FROM},
+					SkipTotalCount: true,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Experiences).To(HaveLen(2))
+			Expect(resp.HasMore).To(BeTrue())
+			Expect(resp.NextPageToken).ToNot(BeEmpty())
+
+			resp, err = experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{
+					Limit:          2,
+					OrderBy:        &desc.ExperienceOrder{Field: desc.ExperienceOrder_FROM},
+					PageToken:      resp.NextPageToken,
+					SkipTotalCount: true,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Experiences).To(HaveLen(1))
+			Expect(resp.HasMore).To(BeFalse())
+			Expect(resp.NextPageToken).To(BeEmpty())
+		})
+
+		It("List user experiences pages sorted by from", func() {
+			order := models.ExperienceOrder{Field: models.OrderByFrom}
+			experiences := []models.Experience{
+				models.NewExperience(3, 1, 1, time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, 1),
+				models.NewExperience(1, 1, 2, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, 1),
+				models.NewExperience(2, 1, 3, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, 1),
+			}
+
+			mockRepo.EXPECT().
+				List(gomock.Any(), models.ExperienceFilter{UserId: 1}, order, gomock.Nil(), uint64(3), uint64(0)).
+				Return(experiences, nil).
+				Times(1)
+
+			cursor := models.NewCursor(order, experiences[1])
+
+			mockRepo.EXPECT().
+				List(gomock.Any(), models.ExperienceFilter{UserId: 1}, order, &cursor, uint64(3), uint64(0)).
+				Return(experiences[2:], nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListUserExperiencesV1").
+				Times(2)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				AnyTimes()
+
+			resp, err := experienceAPI.ListUserExperiencesV1(ctx, &desc.ListUserExperiencesV1Request{UserId: 1, Limit: 2})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Experiences).To(HaveLen(2))
+			Expect(resp.HasMore).To(BeTrue())
+			Expect(resp.NextPageToken).To(Equal(cursor.Encode()))
+
+			resp, err = experienceAPI.ListUserExperiencesV1(ctx, &desc.ListUserExperiencesV1Request{
+				UserId:    1,
+				Limit:     2,
+				PageToken: resp.NextPageToken,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Experiences).To(HaveLen(1))
+			Expect(resp.HasMore).To(BeFalse())
+			Expect(resp.NextPageToken).To(BeEmpty())
+
+			_, err = experienceAPI.ListUserExperiencesV1(ctx, &desc.ListUserExperiencesV1Request{
+				UserId:    1,
+				Limit:     2,
+				PageToken: models.NewCursor(models.ExperienceOrder{Field: models.OrderByLevel}, experiences[0]).Encode(),
+			})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("Stream experiences by batches", func() {
+			stream := mocks.NewMockOcpExperienceApi_ListExperienceV1StreamServer(mockCtrl)
+			filter := models.ExperienceFilter{UserId: 1}
+			order := models.ExperienceOrder{}
+			first := []models.Experience{
+				models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1),
+				models.NewExperience(2, 1, 2, time.Time{}, time.Time{}, 2),
+			}
+			last := []models.Experience{
+				models.NewExperience(3, 1, 3, time.Time{}, time.Time{}, 3),
+			}
+			cursor := models.NewCursor(order, first[1])
+
+			stream.EXPECT().
+				Context().
+				Return(ctx).
+				AnyTimes()
+
+			gomock.InOrder(
+				mockRepo.EXPECT().
+					List(gomock.Any(), filter, order, nil, uint64(2), uint64(0)).
+					Return(first, nil),
+				mockRepo.EXPECT().
+					List(gomock.Any(), filter, order, &cursor, uint64(2), uint64(0)).
+					Return(last, nil),
+			)
+
+			for _, experience := range append(first, last...) {
+				stream.EXPECT().
+					Send(&desc.ListExperienceV1StreamResponse{
+						Experience: models.ConvertExperienceToAPI(&experience),
+					}).
+					Return(nil).
+					Times(1)
+			}
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListExperienceV1Stream").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			err := experienceAPI.ListExperienceV1Stream(
+				&desc.ListExperienceV1StreamRequest{
+					Filter: &desc.ExperienceFilter{UserId: 1},
+				},
+				stream,
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Stop streaming experiences on cancel", func() {
+			stream := mocks.NewMockOcpExperienceApi_ListExperienceV1StreamServer(mockCtrl)
+			cancelCtx, cancel := context.WithCancel(ctx)
+
+			stream.EXPECT().
+				Context().
+				Return(cancelCtx).
+				AnyTimes()
+
+			mockRepo.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any(), nil, uint64(2), uint64(0)).
+				Return([]models.Experience{
+					models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1),
+					models.NewExperience(2, 1, 2, time.Time{}, time.Time{}, 2),
+				}, nil).
+				Times(1)
+
+			stream.EXPECT().
+				Send(gomock.Any()).
+				DoAndReturn(func(*desc.ListExperienceV1StreamResponse) error {
+					cancel()
+					return nil
+				}).
+				Times(1)
+
+			err := experienceAPI.ListExperienceV1Stream(&desc.ListExperienceV1StreamRequest{}, stream)
+
+			Expect(status.Code(err)).To(Equal(codes.Canceled))
+		})
+
+		It("List() page token validation", func() {
+			token := models.NewCursor(models.ExperienceOrder{}, models.Experience{Id: 1}).Encode()
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			_, err := experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{Limit: 10, PageToken: "not a token"},
+			)
+
+			Expect(err).To(Equal(status.Error(codes.InvalidArgument, models.InvalidCursor.Error())))
+
+			_, err = experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{Limit: 10, Offset: 10, PageToken: token},
+			)
+
+			Expect(err).To(Equal(status.Error(codes.InvalidArgument, "offset cannot be used with page_token")))
+
+			_, err = experienceAPI.ListExperienceV1(
+				ctx, &desc.ListExperienceV1Request{
+					Limit:     10,
+					OrderBy:   &desc.ExperienceOrder{Field: desc.ExperienceOrder_LEVEL},
+					PageToken: token,
+				},
+			)
+
+			Expect(err).To(Equal(status.Error(codes.InvalidArgument, "page_token does not match order_by")))
+		})
+
+		removeTest := func(expectFound bool) {
+			id := uint64(11)
+
+			if expectFound {
+				mockRepo.EXPECT().
+					Remove(gomock.Any(), id, uint64(0)).
+					Return(true, nil).
+					Times(1)
+			} else {
+				mockRepo.EXPECT().
+					Remove(gomock.Any(), id, uint64(0)).
+					Return(false, nil).
+					Times(1)
+			}
+
+			mockProm.EXPECT().
+				IncRemove(uint(1), "RemoveExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.RemoveExperienceV1(
+				ctx, &desc.RemoveExperienceV1Request{
+					Id: id,
+				},
+			)
+
+			Expect(resp).To(Equal(&desc.RemoveExperienceV1Response{
+					Removed: expectFound,
+				}))
+
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+
+		It("Remove existing experience with no errors", func() {
+			removeTest(true)
+		})
+
+		It("Remove non-existing experience with no errors", func() {
+			removeTest(false)
+		})
+
+		It("Restore removed experience", func() {
+			id := uint64(11)
+
+			mockRepo.EXPECT().
+				Restore(gomock.Any(), id).
+				Return(true, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "RestoreExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.RestoreExperienceV1(
+				ctx, &desc.RestoreExperienceV1Request{
+					Id: id,
+				},
+			)
+
+			Expect(resp).To(Equal(&desc.RestoreExperienceV1Response{
+				Restored: true,
+			}))
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("List experience history", func() {
+			id := uint64(11)
+			before := models.NewExperience(id, 1, 1, time.Time{}, time.Time{}, 1)
+			after := models.NewExperience(id, 1, 1, time.Time{}, time.Time{}, 2)
+			records := []models.ExperienceHistory{
+				{Id: 1, ExperienceId: id, Action: models.CreateAction, After: &before},
+				{Id: 2, ExperienceId: id, Action: models.UpdateAction, Actor: "admin", Before: &before, After: &after},
+			}
+
+			mockRepo.EXPECT().
+				ListHistory(gomock.Any(), id, uint64(10), uint64(0)).
+				Return(records, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncList(uint(1), "ListExperienceHistoryV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.ListExperienceHistoryV1(
+				ctx, &desc.ListExperienceHistoryV1Request{
+					Id:    id,
+					Limit: 10,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Records).To(HaveLen(2))
+			Expect(resp.Records[0].Action).To(Equal(desc.ExperienceHistoryRecord_CREATE))
+			Expect(resp.Records[0].Before).To(BeNil())
+			Expect(resp.Records[1].Actor).To(Equal("admin"))
+			Expect(resp.Records[1].After.Level).To(Equal(uint64(2)))
+		})
+
+		It("Update existing experience", func() {
+			req := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
+			mockRepo.EXPECT().
+				Update(gomock.Any(), req, []string{}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "UpdateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id: req.Id,
+					UserId:    	req.UserId,
+					Type:      	req.Type,
+					From:      	timestamppb.New(req.From),
+					To: 		timestamppb.New(req.To),
+					Level: 		req.Level,
+				},
+			)
+
+			Expect(resp).To(Equal(&desc.UpdateExperienceV1Response{}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Update existing experience with unset timestamps", func() {
+			req := models.NewExperience(1, 1, 0, time.Time{}, time.Time{}, 2)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), req.Id).
+				Return(models.NewExperience(1, 1, 1, validFrom, validTo, 1), nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), req, []string{}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "UpdateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:     req.Id,
+					UserId: req.UserId,
+					Level:  req.Level,
+				},
+			)
+
+			Expect(resp).To(Equal(&desc.UpdateExperienceV1Response{}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Update experience fields from update mask", func() {
+			req := models.NewExperience(1, 0, 0, time.Time{}, validTo, 3)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), req.Id).
+				Return(models.NewExperience(1, 1, ladderType, validFrom, time.Time{}, 1), nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), req, []string{models.LevelField, models.ToField}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "UpdateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         req.Id,
+					To:         timestamppb.New(validTo),
+					Level:      3,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"level", "to", "level"}},
+				},
+			)
+
+			Expect(resp).To(Equal(&desc.UpdateExperienceV1Response{}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Update() params validation", func() {
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			_, err := experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"level", "id"}},
+				},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid UpdateExperienceV1Request.UpdateMask: unknown path \"id\""))
+
+			_, err = experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{
+					Id:         1,
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+				},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid UpdateExperienceV1Request.UserId: value must be greater than 0"))
+
+			_, err = experienceAPI.UpdateExperienceV1(
+				ctx, &desc.UpdateExperienceV1Request{Id: 1, Level: 2},
+			)
+
+			Expect(err.Error()).To(Equal("rpc error: code = InvalidArgument desc = invalid UpdateExperienceV1Request.UserId: value must be greater than 0"))
+		})
+
+		It("Update experience with If-Match version", func() {
+			req := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), req, []string{}, uint64(5)).
+				Return(repo.VersionMismatch).
+				Times(1)
+
+			md := metadata.Pairs(api.IfMatchHeader, api.FormatETag(5))
+			_, err := experienceAPI.UpdateExperienceV1(
+				metadata.NewIncomingContext(ctx, md), &desc.UpdateExperienceV1Request{
+					Id:     req.Id,
+					UserId: req.UserId,
+					Type:   req.Type,
+					From:   timestamppb.New(req.From),
+					To:     timestamppb.New(req.To),
+					Level:  req.Level,
+				},
+			)
+
+			Expect(err).To(Equal(status.Error(codes.FailedPrecondition, repo.VersionMismatch.Error())))
+		})
+
+		It("Remove experience with expected version", func() {
+			mockRepo.EXPECT().
+				Remove(gomock.Any(), uint64(11), uint64(2)).
+				Return(false, repo.VersionMismatch).
+				Times(1)
+
+			_, err := experienceAPI.RemoveExperienceV1(
+				ctx, &desc.RemoveExperienceV1Request{Id: 11, ExpectedVersion: 2},
+			)
+
+			Expect(err).To(Equal(status.Error(codes.FailedPrecondition, repo.VersionMismatch.Error())))
+		})
+
+		It("Describe existing experience", func() {
+			experience := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), experience.Id).
+				Return(experience, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncRead(uint(1), "DescribeExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
 
 			resp, err := experienceAPI.DescribeExperienceV1(
 				ctx, &desc.DescribeExperienceV1Request{
@@ -336,12 +1707,649 @@ var _ = Describe("Api", func() {
 				},
 			)
 
-			Expect(resp).
-				To(Equal(&desc.DescribeExperienceV1Response{
-					Experience: models.ConvertExperienceToAPI(&experience),
-				}))
+			Expect(resp).
+				To(Equal(&desc.DescribeExperienceV1Response{
+					Experience: models.ConvertExperienceToAPI(&experience),
+				}))
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Describe experience with type name", func() {
+			experience := models.NewExperience(1, 1, 3, time.Time{}, time.Time{}, 1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), experience.Id).
+				Return(experience, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncRead(uint(1), "DescribeExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.DescribeExperienceV1(
+				ctx, &desc.DescribeExperienceV1Request{
+					Id:              experience.Id,
+					IncludeTypeName: true,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Experience.TypeName).To(Equal("type 3"))
+		})
+
+		It("Summarize user experiences by type", func() {
+			mockRepo.EXPECT().
+				UserExperiences(gomock.Any(), uint64(1)).
+				Return([]models.Experience{
+					models.NewExperience(1, 1, 1, time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), 2),
+					models.NewExperience(2, 1, 1, time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), 3),
+					models.NewExperience(3, 1, 2, validFrom, time.Time{}, 1),
+				}, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncRead(uint(1), "GetUserExperienceSummaryV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.GetUserExperienceSummaryV1(
+				ctx, &desc.GetUserExperienceSummaryV1Request{UserId: 1, IncludeTypeName: true},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Types).To(HaveLen(2))
+
+			Expect(resp.Types[0].Type).To(Equal(uint64(1)))
+			Expect(resp.Types[0].TypeName).To(Equal("type 1"))
+			Expect(resp.Types[0].Duration.AsDuration()).To(Equal(730 * 24 * time.Hour))
+			Expect(resp.Types[0].MaxLevel).To(Equal(uint64(3)))
+			Expect(resp.Types[0].FirstFrom.AsTime()).To(Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(resp.Types[0].LastTo.AsTime()).To(Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(resp.Types[0].Ongoing).To(BeFalse())
+
+			Expect(resp.Types[1].Type).To(Equal(uint64(2)))
+			Expect(resp.Types[1].Duration.AsDuration()).To(BeNumerically(">", time.Since(validFrom)-time.Minute))
+			Expect(resp.Types[1].LastTo).To(BeNil())
+			Expect(resp.Types[1].Ongoing).To(BeTrue())
+		})
+
+		It("Reject overlapping experience", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapReject)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), models.NewExperience(0, 1, 1, validFrom, validTo, 1)).
+				Return([]models.Experience{models.NewExperience(5, 1, 1, validFrom, time.Time{}, 2)}, nil).
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   1,
+					From:   timestamppb.New(validFrom),
+					To:     timestamppb.New(validTo),
+					Level:  1,
+				},
+			)
+
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			failure, ok := status.Convert(err).Details()[0].(*errdetails.PreconditionFailure)
+
+			Expect(ok).To(BeTrue())
+			Expect(failure.Violations).To(HaveLen(1))
+			Expect(failure.Violations[0].Subject).To(Equal("experiences/5"))
+		})
+
+		It("Merge overlapping experiences", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapMerge)
+
+			first := models.NewExperience(5, 1, 1, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), 3)
+			second := models.NewExperience(6, 1, 1, time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 2)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			// merged period overlaps one more experience
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), gomock.Any()).
+				Return([]models.Experience{first}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), gomock.Any()).
+				Return([]models.Experience{first, second}, nil).
+				Times(2)
+
+			mockRepo.EXPECT().
+				Update(
+					gomock.Any(),
+					models.NewExperience(5, 1, 1, first.From, second.To, 3),
+					[]string{models.FromField, models.ToField, models.LevelField},
+					uint64(0),
+				).
+				Return(nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Remove(gomock.Any(), second.Id, uint64(0)).
+				Return(true, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(1), "CreateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.CreateExperienceV1(
+				ctx, &desc.CreateExperienceV1Request{
+					UserId: 1,
+					Type:   1,
+					From:   timestamppb.New(validFrom),
+					To:     timestamppb.New(validTo),
+					Level:  1,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(&desc.CreateExperienceV1Response{Id: 5, MergedIds: []uint64{5, 6}}))
+		})
+
+		It("Reject restoring overlapping experience", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapReject)
+
+			restored := models.NewExperience(7, 1, 1, validFrom, validTo, 1)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Restore(gomock.Any(), restored.Id).
+				Return(true, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), restored.Id).
+				Return(restored, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), restored).
+				Return([]models.Experience{models.NewExperience(5, 1, 1, validFrom, time.Time{}, 2)}, nil).
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			_, err := experienceAPI.RestoreExperienceV1(ctx, &desc.RestoreExperienceV1Request{Id: restored.Id})
+
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			failure, ok := status.Convert(err).Details()[0].(*errdetails.PreconditionFailure)
+
+			Expect(ok).To(BeTrue())
+			Expect(failure.Violations).To(HaveLen(1))
+			Expect(failure.Violations[0].Subject).To(Equal("experiences/5"))
+		})
+
+		It("Merge overlapping experiences into restored experience", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapMerge)
+
+			restored := models.NewExperience(7, 1, 1, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 1)
+			other := models.NewExperience(5, 1, 1, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), 3)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Restore(gomock.Any(), restored.Id).
+				Return(true, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), restored.Id).
+				Return(restored, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), gomock.Any()).
+				Return([]models.Experience{other}, nil).
+				Times(2)
+
+			mockRepo.EXPECT().
+				Update(
+					gomock.Any(),
+					models.NewExperience(7, 1, 1, other.From, restored.To, 3),
+					[]string{models.FromField, models.ToField, models.LevelField},
+					uint64(0),
+				).
+				Return(nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Remove(gomock.Any(), other.Id, uint64(0)).
+				Return(true, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "RestoreExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any(), gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.RestoreExperienceV1(ctx, &desc.RestoreExperienceV1Request{Id: restored.Id})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(&desc.RestoreExperienceV1Response{Restored: true}))
+		})
+
+		It("Reject overlapping experiences of batch operations", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapReject)
+
+			created := models.NewExperience(0, 1, 1, validFrom, validTo, 1)
+			overlapping := models.NewExperience(0, 2, 1, validFrom, validTo, 1)
+			updated := models.NewExperience(3, 1, 1, validFrom, validTo, 1)
+			stored := models.NewExperience(5, 2, 1, validFrom, time.Time{}, 2)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				AnyTimes()
+
+			// the batch fails on the overlapping experience and is retried one at a time
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), created).
+				Return(nil, nil).
+				Times(2)
+
+			mockRepo.EXPECT().
+				Add(gomock.Any(), created).
+				Return(uint64(1), nil).
+				Times(2)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), overlapping).
+				Return([]models.Experience{stored}, nil).
+				Times(2)
+
+			// the rejected experience is checked before it is written
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), updated.Id).
+				Return(updated, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), updated).
+				Return([]models.Experience{models.NewExperience(6, 1, 1, validFrom, time.Time{}, 2)}, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(1), "MultiCreateExperienceV1").
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(0), "MultiUpdateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(3)
+
+			createResp, err := experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+						{UserId: 2, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+					},
+					Mode: desc.MultiCreateExperienceV1Request_BEST_EFFORT,
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createResp.Ids).To(Equal([]uint64{1}))
+			Expect(createResp.Results).To(HaveLen(2))
+			Expect(createResp.Results[0]).To(Equal(&desc.ExperienceBatchResult{Id: 1}))
+			Expect(createResp.Results[1].Status).To(Equal(desc.ExperienceBatchResult_OVERLAP))
+
+			updateResp, err := experienceAPI.MultiUpdateExperienceV1(
+				ctx, &desc.MultiUpdateExperienceV1Request{
+					Experiences: []*desc.UpdateExperienceV1Request{
+						{
+							Id:     updated.Id,
+							UserId: updated.UserId,
+							Type:   updated.Type,
+							From:   timestamppb.New(validFrom),
+							To:     timestamppb.New(validTo),
+							Level:  updated.Level,
+						},
+					},
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateResp.Results).To(HaveLen(1))
+			Expect(updateResp.Results[0].Id).To(Equal(updated.Id))
+			Expect(updateResp.Results[0].Status).To(Equal(desc.ExperienceBatchResult_OVERLAP))
+		})
+
+		It("Update batch with rejected overlapping experience in one transaction", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapReject)
+
+			rejected := models.NewExperience(3, 1, 1, validFrom, validTo, 1)
+			accepted := models.NewExperience(4, 2, 1, validFrom, validTo, 1)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), rejected.Id).
+				Return(rejected, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), rejected).
+				Return([]models.Experience{models.NewExperience(6, 1, 1, validFrom, time.Time{}, 2)}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), accepted.Id).
+				Return(accepted, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), accepted).
+				Return(nil, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), accepted, []string{}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "MultiUpdateExperienceV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(2)
+
+			var items []*desc.UpdateExperienceV1Request
+
+			for _, experience := range []models.Experience{rejected, accepted} {
+				items = append(items, &desc.UpdateExperienceV1Request{
+					Id:     experience.Id,
+					UserId: experience.UserId,
+					Type:   experience.Type,
+					From:   timestamppb.New(validFrom),
+					To:     timestamppb.New(validTo),
+					Level:  experience.Level,
+				})
+			}
+
+			resp, err := experienceAPI.MultiUpdateExperienceV1(ctx, &desc.MultiUpdateExperienceV1Request{Experiences: items})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Results).To(HaveLen(2))
+			Expect(resp.Results[0].Status).To(Equal(desc.ExperienceBatchResult_OVERLAP))
+			Expect(resp.Results[1]).To(Equal(&desc.ExperienceBatchResult{Id: accepted.Id}))
+		})
+
+		It("Merge overlapping experiences of batch operations", func() {
+			experienceAPI = api.NewExperienceApi(mockRepo, 2, mockProm, mockProducer, opentracing.NoopTracer{}, api.OverlapMerge)
+
+			merged := models.NewExperience(0, 1, 1, validFrom, validTo, 1)
+			separate := models.NewExperience(0, 2, 1, validFrom, validTo, 1)
+			updated := models.NewExperience(3, 3, 1, validFrom, validTo, 1)
+			first := models.NewExperience(5, 1, 1, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), 3)
+			second := models.NewExperience(6, 3, 1, time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 2)
+
+			mergedUnion := merged.Merge(first)
+			mergedUnion.Id = first.Id
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				AnyTimes()
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), merged).
+				Return([]models.Experience{first}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), merged.Merge(first)).
+				Return([]models.Experience{first}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), mergedUnion, []string{models.FromField, models.ToField, models.LevelField}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), separate).
+				Return(nil, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Add(gomock.Any(), separate).
+				Return(uint64(7), nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), updated, []string{}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Describe(gomock.Any(), updated.Id).
+				Return(updated, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), updated).
+				Return([]models.Experience{second}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Overlapping(gomock.Any(), updated.Merge(second)).
+				Return([]models.Experience{second}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Update(gomock.Any(), updated.Merge(second), []string{models.FromField, models.ToField, models.LevelField}, uint64(0)).
+				Return(nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				Remove(gomock.Any(), second.Id, uint64(0)).
+				Return(true, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(2), "MultiCreateExperienceV1").
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "MultiUpdateExperienceV1").
+				Times(1)
+
+			// merged and created experience events, then removed merged and updated experience events
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(4)
+
+			createResp, err := experienceAPI.MultiCreateExperienceV1(
+				ctx, &desc.MultiCreateExperienceV1Request{
+					Experiences: []*desc.CreateExperienceV1Request{
+						{UserId: 1, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+						{UserId: 2, Type: 1, From: timestamppb.New(validFrom), To: timestamppb.New(validTo), Level: 1},
+					},
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createResp.Ids).To(Equal([]uint64{first.Id, 7}))
+
+			updateResp, err := experienceAPI.MultiUpdateExperienceV1(
+				ctx, &desc.MultiUpdateExperienceV1Request{
+					Experiences: []*desc.UpdateExperienceV1Request{
+						{
+							Id:     updated.Id,
+							UserId: updated.UserId,
+							Type:   updated.Type,
+							From:   timestamppb.New(validFrom),
+							To:     timestamppb.New(validTo),
+							Level:  updated.Level,
+						},
+					},
+				},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateResp.Results).To(Equal([]*desc.ExperienceBatchResult{{Id: updated.Id}}))
+		})
+
+		It("Find overlapping experiences", func() {
+			mockRepo.EXPECT().
+				FindOverlaps(gomock.Any(), uint64(1), uint64(10), uint64(0)).
+				Return([]models.Overlap{{UserId: 1, Type: 1, FirstId: 5, SecondId: 6}}, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncList(uint(1), "FindOverlapsV1").
+				Times(1)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.FindOverlapsV1(ctx, &desc.FindOverlapsV1Request{UserId: 1, Limit: 10})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Overlaps).To(Equal([]*desc.ExperienceOverlap{{UserId: 1, Type: 1, FirstId: 5, SecondId: 6}}))
+		})
+
+		It("Normalize user experiences", func() {
+			date := func(year int, month time.Month) time.Time {
+				return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+			}
+
+			experiences := []models.Experience{
+				models.NewExperience(1, 1, 1, date(2018, 1), date(2019, 1), 1),
+				models.NewExperience(2, 1, 1, date(2019, 1), date(2020, 1), 1),
+				models.NewExperience(3, 1, 1, date(2020, 1), date(2021, 1), 2),
+				models.NewExperience(4, 1, 1, date(2020, 6), time.Time{}, 3),
+				models.NewExperience(5, 1, 2, date(2018, 1), date(2019, 1), 1),
+			}
+
+			created := []models.Experience{
+				models.NewExperience(0, 1, 1, date(2018, 1), date(2020, 1), 1),
+				models.NewExperience(0, 1, 1, date(2020, 1), time.Time{}, 3),
+			}
+
+			mockRepo.EXPECT().
+				UserExperiences(gomock.Any(), uint64(1)).
+				Return(experiences, nil).
+				Times(2)
+
+			mockRepo.EXPECT().
+				RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(tx repo.IRepo) error) error {
+					return fn(mockRepo)
+				}).
+				Times(1)
+
+			mockRepo.EXPECT().
+				RemoveExperiences(gomock.Any(), experiences[:4]).
+				Return([]error{nil, nil, nil, nil}, nil).
+				Times(1)
+
+			mockRepo.EXPECT().
+				AddExperiences(gomock.Any(), created).
+				Return([]uint64{10, 11}, nil).
+				Times(1)
+
+			mockProm.EXPECT().
+				IncRemove(uint(4), "NormalizeUserExperiencesV1").
+				Times(1)
+
+			mockProm.EXPECT().
+				IncCreate(uint(2), "NormalizeUserExperiencesV1").
+				Times(1)
+
+			mockProm.EXPECT().
+				IncUpdate(uint(1), "NormalizeUserExperiencesV1").
+				Times(2)
+
+			mockProducer.EXPECT().
+				Send(gomock.Any()).
+				Times(1)
+
+			resp, err := experienceAPI.NormalizeUserExperiencesV1(
+				ctx, &desc.NormalizeUserExperiencesV1Request{UserId: 1, DryRun: true},
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.RemovedIds).To(Equal([]uint64{1, 2, 3, 4}))
+			Expect(resp.CreatedIds).To(BeEmpty())
+			Expect(resp.Created).To(HaveLen(2))
+
+			resp, err = experienceAPI.NormalizeUserExperiencesV1(
+				ctx, &desc.NormalizeUserExperiencesV1Request{UserId: 1},
+			)
 
 			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.RemovedIds).To(Equal([]uint64{1, 2, 3, 4}))
+			Expect(resp.CreatedIds).To(Equal([]uint64{10, 11}))
+			Expect(resp.Created[1].Id).To(Equal(uint64(11)))
+			Expect(resp.Created[1].To).To(BeNil())
+			Expect(resp.Created[1].Level).To(Equal(uint64(3)))
 		})
 
 		It("Describe no existing experience", func() {
@@ -0,0 +1,251 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+
+	repository "github.com/ozoncp/ocp-experience-api/internal/repo"
+)
+
+// IdempotencyKeyHeader is a metadata key of the idempotency key, it is used if request key field is not set
+const IdempotencyKeyHeader = "idempotency-key"
+
+// maximal idempotency key length
+const maxIdempotencyKeyLen = 128
+
+// name of request field holding idempotency key
+const idempotencyKeyField = "idempotency_key"
+
+// idempotency key ttl and lease used if they are not configured
+const (
+	defaultIdempotencyKeyTTL   = 24 * time.Hour
+	defaultIdempotencyKeyLease = time.Minute
+)
+
+// idempotencyStoreTimeout limits storing responses and releasing keys, they do not depend on the request context,
+// so a handled request of a client gone meanwhile still stores its response
+const idempotencyStoreTimeout = 5 * time.Second
+
+// idempotentRequest is a request of RPC opted in to idempotency keys with idempotency_key field
+type idempotentRequest interface {
+	proto.Message
+	GetIdempotencyKey() string
+}
+
+// NewIdempotencyInterceptor returns interceptor replaying responses of requests with idempotency key.
+// Response of the first request with a key is stored for ttl and returned to requests of the same method
+// with the key, the request is not handled again. A key used with another request is rejected
+// with FailedPrecondition, a request with a key that is still handled is rejected with Aborted.
+// The key is reserved for lease while the request is handled, a request with the key retried after the lease
+// is handled again, so a reservation left by a crashed instance does not block the key for ttl.
+// A request stores its response only while it holds the reservation, the response of a request outliving its lease
+// is returned but not stored. Failed requests and responses failed to be stored are not kept, so they may be retried
+// with the same key. Not positive ttl and lease are replaced with defaults of 24 hours and a minute
+func NewIdempotencyInterceptor(repo repository.IRepo, ttl, lease time.Duration) grpc.UnaryServerInterceptor {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyKeyTTL
+	}
+
+	if lease <= 0 {
+		lease = defaultIdempotencyKeyLease
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		request, ok := req.(idempotentRequest)
+
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx, request)
+
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d", maxIdempotencyKeyLen)
+		}
+
+		hash, err := requestHash(request)
+
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+		}
+
+		token, err := leaseToken()
+
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate idempotency lease: %v", err)
+		}
+
+		record := models.IdempotencyRecord{
+			Method:      info.FullMethod,
+			Key:         key,
+			RequestHash: hash,
+			Lease:       token,
+			ExpiresAt:   time.Now().Add(lease),
+		}
+
+		reserved, err := repo.ReserveIdempotencyKey(ctx, record)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !reserved {
+			return replay(ctx, repo, record)
+		}
+
+		resp, err := handler(ctx, req)
+
+		storeCtx, cancel := context.WithTimeout(context.Background(), idempotencyStoreTimeout)
+		defer cancel()
+
+		if err != nil {
+			release(storeCtx, repo, record)
+			return nil, err
+		}
+
+		err = complete(storeCtx, repo, record, resp, time.Now().Add(ttl))
+
+		if errors.Is(err, repository.IdempotencyLeaseLost) {
+			log.Warn().Str("method", record.Method).Msgf("Idempotency key lease expired before response was stored")
+		} else if err != nil {
+			log.Error().Err(err).Str("method", record.Method).Msgf("Failed to store idempotent response")
+			release(storeCtx, repo, record)
+		}
+
+		return resp, nil
+	}
+}
+
+// idempotencyKey returns request idempotency key, if the field is not set the key is taken from metadata
+func idempotencyKey(ctx context.Context, request idempotentRequest) string {
+	if key := request.GetIdempotencyKey(); key != "" {
+		return key
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(IdempotencyKeyHeader)
+
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// leaseToken returns a random token identifying reservation of the request
+func leaseToken() (string, error) {
+	token := make([]byte, 16)
+
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// requestHash returns hash of request without idempotency key
+func requestHash(request idempotentRequest) ([]byte, error) {
+	message := proto.Clone(request).ProtoReflect()
+
+	if field := message.Descriptor().Fields().ByName(idempotencyKeyField); field != nil {
+		message.Clear(field)
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message.Interface())
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
+
+// replay returns stored response of the request with idempotency key
+func replay(ctx context.Context, repo repository.IRepo, record models.IdempotencyRecord) (interface{}, error) {
+	stored, err := repo.IdempotencyKey(ctx, record.Method, record.Key)
+
+	if errors.Is(err, repository.IdempotencyKeyNotFound) {
+		return nil, status.Error(codes.Aborted, "request with the idempotency key has failed or expired, retry")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(stored.RequestHash, record.RequestHash) {
+		return nil, status.Error(codes.FailedPrecondition, "idempotency key is used with another request")
+	}
+
+	if stored.Response == nil {
+		return nil, status.Error(codes.Aborted, "request with the idempotency key is in progress")
+	}
+
+	response := &anypb.Any{}
+
+	if err := proto.Unmarshal(stored.Response, response); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read stored response: %v", err)
+	}
+
+	message, err := response.UnmarshalNew()
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read stored response: %v", err)
+	}
+
+	return message, nil
+}
+
+// complete stores response of the request with idempotency key until expiresAt.
+// Returns IdempotencyLeaseLost error if the request does not hold the key anymore
+func complete(ctx context.Context, repo repository.IRepo, record models.IdempotencyRecord, resp interface{}, expiresAt time.Time) error {
+	message, ok := resp.(proto.Message)
+
+	if !ok {
+		return errors.New("response is not a proto message")
+	}
+
+	response, err := anypb.New(message)
+
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(response)
+
+	if err != nil {
+		return err
+	}
+
+	record.Response = data
+	record.ExpiresAt = expiresAt
+
+	return repo.CompleteIdempotencyKey(ctx, record)
+}
+
+// release deletes reservation of the request with idempotency key, so the request may be retried with the key
+func release(ctx context.Context, repo repository.IRepo, record models.IdempotencyRecord) {
+	if err := repo.ReleaseIdempotencyKey(ctx, record); err != nil {
+		log.Error().Err(err).Str("method", record.Method).Msgf("Failed to release idempotency key")
+	}
+}
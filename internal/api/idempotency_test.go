@@ -0,0 +1,381 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ozoncp/ocp-experience-api/internal/api"
+	"github.com/ozoncp/ocp-experience-api/internal/mocks/mocks"
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+	"github.com/ozoncp/ocp-experience-api/internal/repo"
+
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+var _ = Describe("Idempotency interceptor", func() {
+	const method = "/ocp.experience.api.OcpExperienceApi/CreateExperienceV1"
+
+	var (
+		mockRepo    *mocks.MockIRepo
+		mockCtrl    *gomock.Controller
+		ctx         context.Context
+		interceptor grpc.UnaryServerInterceptor
+		info        *grpc.UnaryServerInfo
+		handled     int
+		handler     grpc.UnaryHandler
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockIRepo(mockCtrl)
+		ctx = context.Background()
+		interceptor = api.NewIdempotencyInterceptor(mockRepo, time.Hour, time.Minute)
+		info = &grpc.UnaryServerInfo{FullMethod: method}
+		handled = 0
+
+		handler = func(_ context.Context, _ interface{}) (interface{}, error) {
+			handled++
+			return &desc.CreateExperienceV1Response{Id: 11}, nil
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("Handles request without key as is", func() {
+		resp, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(1))
+		Expect(resp).To(Equal(&desc.CreateExperienceV1Response{Id: 11}))
+	})
+
+	It("Stores response of the first request with key from metadata", func() {
+		var lease string
+
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(api.IdempotencyKeyHeader, "key"))
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+				Expect(record.Method).To(Equal(method))
+				Expect(record.Key).To(Equal("key"))
+				Expect(record.RequestHash).ToNot(BeEmpty())
+				Expect(record.Lease).ToNot(BeEmpty())
+				Expect(record.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+
+				lease = record.Lease
+				return true, nil
+			}).
+			Times(1)
+
+		mockRepo.EXPECT().
+			CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) error {
+				Expect(record.Method).To(Equal(method))
+				Expect(record.Key).To(Equal("key"))
+				Expect(record.Lease).To(Equal(lease))
+				Expect(record.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Second))
+
+				stored := &anypb.Any{}
+				Expect(proto.Unmarshal(record.Response, stored)).To(Succeed())
+
+				message, err := stored.UnmarshalNew()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(proto.Equal(message, &desc.CreateExperienceV1Response{Id: 11})).To(BeTrue())
+				return nil
+			}).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(1))
+	})
+
+	It("Replays stored response of the request with the same key", func() {
+		var hash []byte
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+				hash = record.RequestHash
+				return false, nil
+			}).
+			Times(1)
+
+		stored, err := anypb.New(&desc.CreateExperienceV1Response{Id: 5})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err := proto.Marshal(stored)
+		Expect(err).ToNot(HaveOccurred())
+
+		mockRepo.EXPECT().
+			IdempotencyKey(gomock.Any(), method, "key").
+			DoAndReturn(func(_ context.Context, _, _ string) (models.IdempotencyRecord, error) {
+				return models.IdempotencyRecord{RequestHash: hash, Response: response}, nil
+			}).
+			Times(1)
+
+		resp, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(0))
+		Expect(proto.Equal(resp.(proto.Message), &desc.CreateExperienceV1Response{Id: 5})).To(BeTrue())
+	})
+
+	It("Hashes request without key", func() {
+		var hashes [][]byte
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+				hashes = append(hashes, record.RequestHash)
+				return true, nil
+			}).
+			Times(2)
+
+		mockRepo.EXPECT().
+			CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(2)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(api.IdempotencyKeyHeader, "key"))
+		_, err = interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1}, info, handler)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(hashes[0]).To(Equal(hashes[1]))
+	})
+
+	It("Rejects key used with another request", func() {
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(false, nil).
+			Times(1)
+
+		mockRepo.EXPECT().
+			IdempotencyKey(gomock.Any(), method, "key").
+			Return(models.IdempotencyRecord{RequestHash: []byte{1}, Response: []byte{}}, nil).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		Expect(handled).To(Equal(0))
+	})
+
+	It("Rejects key of request in progress", func() {
+		var hash []byte
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+				hash = record.RequestHash
+				return false, nil
+			}).
+			Times(1)
+
+		mockRepo.EXPECT().
+			IdempotencyKey(gomock.Any(), method, "key").
+			DoAndReturn(func(_ context.Context, _, _ string) (models.IdempotencyRecord, error) {
+				return models.IdempotencyRecord{RequestHash: hash}, nil
+			}).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+		Expect(handled).To(Equal(0))
+	})
+
+	It("Rejects key of request that failed meanwhile", func() {
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(false, nil).
+			Times(1)
+
+		mockRepo.EXPECT().
+			IdempotencyKey(gomock.Any(), method, "key").
+			Return(models.IdempotencyRecord{}, repo.IdempotencyKeyNotFound).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+	})
+
+	It("Releases key of failed request", func() {
+		expectedError := errors.New("test error")
+
+		handler = func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, expectedError
+		}
+
+		var lease string
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+				lease = record.Lease
+				return true, nil
+			}).
+			Times(1)
+
+		mockRepo.EXPECT().
+			ReleaseIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) error {
+				Expect(record.Key).To(Equal("key"))
+				Expect(record.Lease).To(Equal(lease))
+				return nil
+			}).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).To(Equal(expectedError))
+	})
+
+	It("Releases key of request with response failed to be stored", func() {
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(true, nil).
+			Times(1)
+
+		mockRepo.EXPECT().
+			CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(errors.New("test error")).
+			Times(1)
+
+		mockRepo.EXPECT().
+			ReleaseIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		resp, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(1))
+		Expect(proto.Equal(resp.(proto.Message), &desc.CreateExperienceV1Response{Id: 11})).To(BeTrue())
+	})
+
+	It("Stores response of request cancelled meanwhile", func() {
+		ctx, cancel := context.WithCancel(ctx)
+
+		handler = func(_ context.Context, _ interface{}) (interface{}, error) {
+			cancel()
+			return &desc.CreateExperienceV1Response{Id: 11}, nil
+		}
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(true, nil).
+			Times(1)
+
+		mockRepo.EXPECT().
+			CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ models.IdempotencyRecord) error {
+				Expect(ctx.Err()).ToNot(HaveOccurred())
+				return nil
+			}).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Releases key of request failed after cancellation", func() {
+		ctx, cancel := context.WithCancel(ctx)
+
+		handler = func(_ context.Context, _ interface{}) (interface{}, error) {
+			cancel()
+			return nil, context.Canceled
+		}
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(true, nil).
+			Times(1)
+
+		mockRepo.EXPECT().
+			ReleaseIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ models.IdempotencyRecord) error {
+				Expect(ctx.Err()).ToNot(HaveOccurred())
+				return nil
+			}).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).To(Equal(context.Canceled))
+	})
+
+	It("Keeps key reserved by retried request if lease expired", func() {
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(true, nil).
+			Times(1)
+
+		mockRepo.EXPECT().
+			CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			Return(repo.IdempotencyLeaseLost).
+			Times(1)
+
+		resp, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(1))
+		Expect(proto.Equal(resp.(proto.Message), &desc.CreateExperienceV1Response{Id: 11})).To(BeTrue())
+	})
+
+	It("Uses default ttl and lease if they are not set", func() {
+		interceptor = api.NewIdempotencyInterceptor(mockRepo, 0, 0)
+
+		mockRepo.EXPECT().
+			ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+				Expect(record.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+				return true, nil
+			}).
+			Times(1)
+
+		mockRepo.EXPECT().
+			CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record models.IdempotencyRecord) error {
+				Expect(record.ExpiresAt).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Second))
+				return nil
+			}).
+			Times(1)
+
+		_, err := interceptor(ctx, &desc.CreateExperienceV1Request{UserId: 1, IdempotencyKey: "key"}, info, handler)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(handled).To(Equal(1))
+	})
+
+	It("Rejects too long key", func() {
+		key := string(make([]byte, 129))
+
+		_, err := interceptor(ctx, &desc.MultiCreateExperienceV1Request{IdempotencyKey: key}, info, handler)
+
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		Expect(handled).To(Equal(0))
+	})
+})
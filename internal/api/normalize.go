@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+	"github.com/ozoncp/ocp-experience-api/internal/producer"
+
+	repository "github.com/ozoncp/ocp-experience-api/internal/repo"
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// NormalizeUserExperiencesV1 merges overlapping experiences of a user type and adjacent ones of the same level
+// in a single transaction. Merged experiences are removed and replaced with created ones, nothing is changed in dry run
+func (r *ExperienceAPI) NormalizeUserExperiencesV1(ctx context.Context, req *desc.NormalizeUserExperiencesV1Request) (*desc.NormalizeUserExperiencesV1Response, error) {
+	log.Printf("NormalizeUserExperiencesV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "NormalizeUserExperiencesV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.UpdateEvent); err != nil {
+		return nil, err
+	}
+
+	var removed, created []models.Experience
+	var createdIds []uint64
+
+	normalize := func(repo repository.IRepo) error {
+		experiences, err := repo.UserExperiences(ctx, req.UserId)
+
+		if err != nil {
+			return err
+		}
+
+		removed, created = models.Normalize(experiences)
+
+		if req.DryRun || len(removed) == 0 {
+			return nil
+		}
+
+		errs, err := repo.RemoveExperiences(ctx, removed)
+
+		if err != nil {
+			return err
+		}
+
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+
+		createdIds, err = repo.AddExperiences(ctx, created)
+		return err
+	}
+
+	var err error
+
+	if req.DryRun {
+		err = normalize(r.repo)
+	} else {
+		err = r.repo.RunInTx(ctx, normalize)
+	}
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("endpoint", "NormalizeUserExperiencesV1").
+			Uint64("user_id", req.UserId).
+			Bool("dry_run", req.DryRun).
+			Msgf("Failed to normalize experiences")
+
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.UpdateEvent, err))
+		return nil, err
+	}
+
+	response := &desc.NormalizeUserExperiencesV1Response{
+		RemovedIds: make([]uint64, 0, len(removed)),
+		CreatedIds: createdIds,
+		Created:    make([]*desc.Experience, 0, len(created)),
+	}
+
+	events := make([]producer.EventMsg, 0, len(removed)+len(created))
+
+	for _, experience := range removed {
+		response.RemovedIds = append(response.RemovedIds, experience.Id)
+		events = append(events, producer.NewEvent(ctx, experience.Id, producer.DeleteEvent, nil))
+	}
+
+	for index, experience := range created {
+		if index < len(createdIds) {
+			experience.Id = createdIds[index]
+			events = append(events, producer.NewEvent(ctx, experience.Id, producer.CreateEvent, nil))
+		}
+
+		response.Created = append(response.Created, models.ConvertExperienceToAPI(&experience))
+	}
+
+	if !req.DryRun && len(events) > 0 {
+		r.producer.Send(events...)
+		r.metrics.IncRemove(uint(len(removed)), "NormalizeUserExperiencesV1")
+		r.metrics.IncCreate(uint(len(created)), "NormalizeUserExperiencesV1")
+	}
+
+	r.metrics.IncUpdate(1, "NormalizeUserExperiencesV1")
+
+	return response, nil
+}
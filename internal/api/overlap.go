@@ -0,0 +1,452 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+	"github.com/ozoncp/ocp-experience-api/internal/producer"
+
+	repository "github.com/ozoncp/ocp-experience-api/internal/repo"
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// OverlapPolicy defines how created and updated experiences overlapping stored experiences
+// of the same user and type are handled
+type OverlapPolicy int
+
+const (
+	OverlapAllow  OverlapPolicy = iota // overlapping experiences are stored as is
+	OverlapReject                      // overlapping experiences are rejected with FailedPrecondition
+	OverlapMerge                       // overlapping experiences are merged into one
+)
+
+// overlap policy names
+var overlapPolicies = map[string]OverlapPolicy{
+	"":       OverlapAllow,
+	"allow":  OverlapAllow,
+	"reject": OverlapReject,
+	"merge":  OverlapMerge,
+}
+
+// ParseOverlapPolicy returns overlap policy by name: allow, reject or merge. Empty name means allow
+func ParseOverlapPolicy(name string) (OverlapPolicy, error) {
+	policy, ok := overlapPolicies[name]
+
+	if !ok {
+		return OverlapAllow, fmt.Errorf("unknown overlap policy %q", name)
+	}
+
+	return policy, nil
+}
+
+// fields written to experience merged with overlapping ones
+var mergedFields = []string{models.FromField, models.ToField, models.LevelField}
+
+// fields defining experience overlaps
+var overlapFields = []string{models.UserIdField, models.TypeField, models.FromField, models.ToField}
+
+// FindOverlapsV1 returns pairs of stored experiences of the same user and type with overlapping periods
+func (r *ExperienceAPI) FindOverlapsV1(ctx context.Context, req *desc.FindOverlapsV1Request) (*desc.FindOverlapsV1Response, error) {
+	log.Printf("FindOverlapsV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "FindOverlapsV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.ReadEvent); err != nil {
+		return nil, err
+	}
+
+	overlaps, err := r.repo.FindOverlaps(ctx, req.UserId, req.Limit, req.Offset)
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("endpoint", "FindOverlapsV1").
+			Uint64("user_id", req.UserId).
+			Msgf("Failed to find overlapping experiences")
+
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, err))
+		return nil, err
+	}
+
+	result := make([]*desc.ExperienceOverlap, 0, len(overlaps))
+
+	for _, overlap := range overlaps {
+		result = append(result, models.ConvertOverlapToAPI(&overlap))
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, 0, producer.ReadEvent, nil))
+	r.metrics.IncList(1, "FindOverlapsV1")
+
+	return &desc.FindOverlapsV1Response{
+		Overlaps: result,
+	}, nil
+}
+
+// addWithPolicy adds experience to repo applying the overlap policy. Returns id of created experience or ids of stored
+// experiences the experience is merged with, the first one is kept with returned id and the others are removed
+func (r *ExperienceAPI) addWithPolicy(ctx context.Context, repo repository.IRepo, experience models.Experience) (uint64, []uint64, error) {
+	if r.overlapPolicy == OverlapAllow {
+		id, err := repo.Add(ctx, experience)
+		return id, nil, err
+	}
+
+	var id uint64
+	var mergedIds []uint64
+
+	err := repo.RunInTx(ctx, func(tx repository.IRepo) error {
+		union, merged, err := r.applyOverlapPolicy(ctx, tx, experience)
+
+		if err != nil {
+			return err
+		}
+
+		if len(merged) == 0 {
+			id, err = tx.Add(ctx, experience)
+			return err
+		}
+
+		// the experience with the least id is kept
+		union.Id = merged[0].Id
+		id = union.Id
+
+		if err := tx.Update(ctx, union, mergedFields, 0); err != nil {
+			return err
+		}
+
+		removedIds, err := removeMerged(ctx, tx, merged[1:])
+		mergedIds = append([]uint64{id}, removedIds...)
+
+		return err
+	})
+
+	return id, mergedIds, err
+}
+
+// addExperiences adds experiences to repo applying the overlap policy to each of them in one transaction, so later
+// experiences are checked against earlier ones. Returns ids of created experiences and per experience ids
+// of stored experiences it is merged with
+func (r *ExperienceAPI) addExperiences(ctx context.Context, repo repository.IRepo, experiences []models.Experience) ([]uint64, [][]uint64, error) {
+	if r.overlapPolicy == OverlapAllow {
+		ids, err := repo.AddExperiences(ctx, experiences)
+		return ids, nil, err
+	}
+
+	ids := make([]uint64, 0, len(experiences))
+	mergedIds := make([][]uint64, 0, len(experiences))
+
+	err := repo.RunInTx(ctx, func(tx repository.IRepo) error {
+		for _, experience := range experiences {
+			id, merged, err := r.addWithPolicy(ctx, tx, experience)
+
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, id)
+			mergedIds = append(mergedIds, merged)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ids, mergedIds, nil
+}
+
+// updateWithPolicy updates experience applying the overlap policy if fields defining overlaps are written.
+// Returns ids of removed experiences merged into the updated one
+func (r *ExperienceAPI) updateWithPolicy(ctx context.Context, experience models.Experience, fields []string, version uint64) ([]uint64, error) {
+	if r.overlapPolicy == OverlapAllow || !writesAny(writtenFields(experience, fields), overlapFields) {
+		return nil, r.repo.Update(ctx, experience, fields, version)
+	}
+
+	var mergedIds []uint64
+
+	err := r.repo.RunInTx(ctx, func(tx repository.IRepo) error {
+		var err error
+		mergedIds, err = r.updateInTx(ctx, tx, experience, fields, version)
+
+		return err
+	})
+
+	return mergedIds, err
+}
+
+// restoreWithPolicy restores removed experience applying the overlap policy to it in a transaction, so a rejected
+// experience stays removed. Returns whether experience is restored and ids of removed experiences merged into it
+func (r *ExperienceAPI) restoreWithPolicy(ctx context.Context, id uint64) (bool, []uint64, error) {
+	if r.overlapPolicy == OverlapAllow {
+		restored, err := r.repo.Restore(ctx, id)
+		return restored, nil, err
+	}
+
+	var restored bool
+	var mergedIds []uint64
+
+	err := r.repo.RunInTx(ctx, func(tx repository.IRepo) error {
+		var err error
+		restored, err = tx.Restore(ctx, id)
+
+		if err != nil || !restored {
+			return err
+		}
+
+		stored, err := tx.Describe(ctx, id)
+
+		if err != nil {
+			return err
+		}
+
+		union, merged, err := r.applyOverlapPolicy(ctx, tx, stored)
+
+		if err != nil || len(merged) == 0 {
+			return err
+		}
+
+		if err := tx.Update(ctx, union, mergedFields, 0); err != nil {
+			return err
+		}
+
+		mergedIds, err = removeMerged(ctx, tx, merged)
+		return err
+	})
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return restored, mergedIds, nil
+}
+
+// updateExperiences updates experiences the same way as repo UpdateExperiences does, applying the overlap policy.
+// Experiences are updated in one transaction, an experience rejected by the policy is checked before it is written,
+// so it gets an overlap error and the others are updated. Events of removed merged experiences are sent
+func (r *ExperienceAPI) updateExperiences(ctx context.Context, experiences []models.Experience, fields [][]string) ([]error, error) {
+	if r.overlapPolicy == OverlapAllow {
+		return r.repo.UpdateExperiences(ctx, experiences, fields)
+	}
+
+	errs := make([]error, len(experiences))
+	var mergedIds []uint64
+
+	err := r.repo.RunInTx(ctx, func(tx repository.IRepo) error {
+		mergedIds = nil
+
+		for index, experience := range experiences {
+			var merged []uint64
+			var err error
+
+			if writesAny(writtenFields(experience, fields[index]), overlapFields) {
+				merged, err = r.updateInTx(ctx, tx, experience, fields[index], experience.Version)
+			} else {
+				err = tx.Update(ctx, experience, fields[index], experience.Version)
+			}
+
+			if err != nil && !isExperienceError(err) {
+				return err
+			}
+
+			errs[index] = err
+			mergedIds = append(mergedIds, merged...)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mergedId := range mergedIds {
+		r.producer.Send(producer.NewEvent(ctx, mergedId, producer.DeleteEvent, nil))
+	}
+
+	return errs, nil
+}
+
+// updateInTx updates experience in transaction tx applying the overlap policy to the experience with written fields.
+// The policy is applied before the experience is written, so a rejected experience is not changed.
+// Returns ids of removed experiences merged into the updated one
+func (r *ExperienceAPI) updateInTx(ctx context.Context, tx repository.IRepo, experience models.Experience, fields []string, version uint64) ([]uint64, error) {
+	stored, err := tx.Describe(ctx, experience.Id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if version != 0 && stored.Version != version {
+		return nil, repository.VersionMismatch
+	}
+
+	union, merged, err := r.applyOverlapPolicy(ctx, tx, withFields(stored, experience, writtenFields(experience, fields)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Update(ctx, experience, fields, version); err != nil {
+		return nil, err
+	}
+
+	if len(merged) == 0 {
+		return nil, nil
+	}
+
+	if err := tx.Update(ctx, union, mergedFields, 0); err != nil {
+		return nil, err
+	}
+
+	return removeMerged(ctx, tx, merged)
+}
+
+// withFields returns stored experience with written fields of experience
+func withFields(stored, experience models.Experience, written []string) models.Experience {
+	for _, field := range written {
+		switch field {
+		case models.UserIdField:
+			stored.UserId = experience.UserId
+		case models.TypeField:
+			stored.Type = experience.Type
+		case models.FromField:
+			stored.From = experience.From
+		case models.ToField:
+			stored.To = experience.To
+		case models.LevelField:
+			stored.Level = experience.Level
+		}
+	}
+
+	return stored
+}
+
+// isExperienceError reports whether err is reported per experience of a batch instead of failing the batch
+func isExperienceError(err error) bool {
+	return errors.Is(err, repository.NotFound) ||
+		errors.Is(err, repository.VersionMismatch) ||
+		status.Code(err) == codes.FailedPrecondition
+}
+
+// applyOverlapPolicy returns overlap status error if the policy rejects overlaps, otherwise returns experience
+// merged with all overlapping experiences and merged experiences sorted by id.
+// Merging is repeated while the merged period overlaps more experiences
+func (r *ExperienceAPI) applyOverlapPolicy(ctx context.Context, tx repository.IRepo, experience models.Experience) (models.Experience, []models.Experience, error) {
+	union := experience
+	merged := make([]models.Experience, 0)
+	seen := make(map[uint64]bool)
+
+	for {
+		overlapping, err := tx.Overlapping(ctx, union)
+
+		if err != nil {
+			return union, nil, err
+		}
+
+		if len(overlapping) > 0 && r.overlapPolicy == OverlapReject {
+			return union, nil, overlapError(overlapping)
+		}
+
+		found := false
+
+		for _, other := range overlapping {
+			if !seen[other.Id] {
+				seen[other.Id] = true
+				found = true
+				merged = append(merged, other)
+				union = union.Merge(other)
+			}
+		}
+
+		if !found {
+			break
+		}
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].Id < merged[j].Id
+	})
+
+	return union, merged, nil
+}
+
+// removeMerged removes experiences merged into another one, returns their ids
+func removeMerged(ctx context.Context, tx repository.IRepo, merged []models.Experience) ([]uint64, error) {
+	ids := make([]uint64, 0, len(merged))
+
+	for _, experience := range merged {
+		if _, err := tx.Remove(ctx, experience.Id, 0); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, experience.Id)
+	}
+
+	return ids, nil
+}
+
+// sendAdded sends event of experience added by addWithPolicy
+func (r *ExperienceAPI) sendAdded(ctx context.Context, id uint64, mergedIds []uint64, err error) {
+	if len(mergedIds) > 0 {
+		r.sendMerged(ctx, id, mergedIds[1:])
+		return
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, id, producer.CreateEvent, err))
+}
+
+// sendMerged sends events of experience the merged experiences are merged into and of removed merged experiences
+func (r *ExperienceAPI) sendMerged(ctx context.Context, id uint64, mergedIds []uint64) {
+	events := []producer.EventMsg{producer.NewEvent(ctx, id, producer.UpdateEvent, nil)}
+
+	for _, mergedId := range mergedIds {
+		events = append(events, producer.NewEvent(ctx, mergedId, producer.DeleteEvent, nil))
+	}
+
+	r.producer.Send(events...)
+}
+
+// overlapError returns FailedPrecondition status listing overlapping experiences
+func overlapError(overlapping []models.Experience) error {
+	message := "experience period overlaps stored experiences of the same user and type"
+	failure := &errdetails.PreconditionFailure{}
+
+	for _, experience := range overlapping {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "OVERLAP",
+			Subject:     fmt.Sprintf("experiences/%d", experience.Id),
+			Description: "experience period overlaps",
+		})
+	}
+
+	st, err := status.New(codes.FailedPrecondition, message).WithDetails(failure)
+
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, message)
+	}
+
+	return st.Err()
+}
+
+// writesAny reports whether any of fields is written
+func writesAny(written []string, fields []string) bool {
+	for _, field := range fields {
+		if containsField(written, field) {
+			return true
+		}
+	}
+
+	return false
+}
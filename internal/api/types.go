@@ -0,0 +1,272 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+	"github.com/ozoncp/ocp-experience-api/internal/producer"
+
+	repository "github.com/ozoncp/ocp-experience-api/internal/repo"
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// ListExperienceTypesV1 returns a list of experience types
+func (r *ExperienceAPI) ListExperienceTypesV1(ctx context.Context, req *desc.ListExperienceTypesV1Request) (*desc.ListExperienceTypesV1Response, error) {
+	log.Printf("ListExperienceTypesV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListExperienceTypesV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeReadEvent); err != nil {
+		return nil, err
+	}
+
+	types, err := r.repo.ListTypes(ctx, req.Limit, req.Offset)
+
+	if err != nil {
+		r.producer.Send(producer.NewEvent(ctx, 0, producer.TypeReadEvent, err))
+
+		log.Error().
+			Err(err).
+			Str("endpoint", "ListExperienceTypesV1").
+			Msgf("Failed to list experience types")
+
+		return nil, err
+	}
+
+	result := make([]*desc.ExperienceType, 0, len(types))
+	eventMessages := make([]producer.EventMsg, 0, len(types))
+
+	for _, experienceType := range types {
+		result = append(result, models.ConvertExperienceTypeToAPI(&experienceType))
+		eventMessages = append(eventMessages, producer.NewEvent(ctx, experienceType.Id, producer.TypeReadEvent, nil))
+	}
+
+	if len(eventMessages) > 0 {
+		r.producer.Send(eventMessages...)
+	}
+
+	r.metrics.IncList(1, "ListExperienceTypesV1")
+
+	return &desc.ListExperienceTypesV1Response{
+		Types: result,
+	}, nil
+}
+
+// DescribeExperienceTypeV1 returns experience type by id
+func (r *ExperienceAPI) DescribeExperienceTypeV1(ctx context.Context, req *desc.DescribeExperienceTypeV1Request) (*desc.DescribeExperienceTypeV1Response, error) {
+	log.Printf("DescribeExperienceTypeV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DescribeExperienceTypeV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeReadEvent); err != nil {
+		return nil, err
+	}
+
+	experienceType, err := r.repo.DescribeType(ctx, req.Id)
+	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.TypeReadEvent, err))
+
+	if err != nil {
+		return nil, typeError(err, "DescribeExperienceTypeV1", req.Id)
+	}
+
+	r.metrics.IncRead(1, "DescribeExperienceTypeV1")
+
+	return &desc.DescribeExperienceTypeV1Response{
+		Type: models.ConvertExperienceTypeToAPI(&experienceType),
+	}, nil
+}
+
+// CreateExperienceTypeV1 adds experience type to the catalog. Returns created type id
+func (r *ExperienceAPI) CreateExperienceTypeV1(ctx context.Context, req *desc.CreateExperienceTypeV1Request) (*desc.CreateExperienceTypeV1Response, error) {
+	log.Printf("CreateExperienceTypeV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateExperienceTypeV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeCreateEvent); err != nil {
+		return nil, err
+	}
+
+	id, err := r.repo.AddType(ctx, models.ExperienceType{Name: req.Name})
+	r.producer.Send(producer.NewEvent(ctx, id, producer.TypeCreateEvent, err))
+
+	if err != nil {
+		return nil, typeError(err, "CreateExperienceTypeV1", 0)
+	}
+
+	r.metrics.IncCreate(1, "CreateExperienceTypeV1")
+
+	return &desc.CreateExperienceTypeV1Response{
+		Id: id,
+	}, nil
+}
+
+// UpdateExperienceTypeV1 renames experience type
+func (r *ExperienceAPI) UpdateExperienceTypeV1(ctx context.Context, req *desc.UpdateExperienceTypeV1Request) (*desc.UpdateExperienceTypeV1Response, error) {
+	log.Printf("UpdateExperienceTypeV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateExperienceTypeV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeUpdateEvent); err != nil {
+		return nil, err
+	}
+
+	err := r.repo.UpdateType(ctx, models.ExperienceType{Id: req.Id, Name: req.Name})
+	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.TypeUpdateEvent, err))
+
+	if err != nil {
+		return nil, typeError(err, "UpdateExperienceTypeV1", req.Id)
+	}
+
+	r.metrics.IncUpdate(1, "UpdateExperienceTypeV1")
+
+	return &desc.UpdateExperienceTypeV1Response{}, nil
+}
+
+// RemoveExperienceTypeV1 removes experience type which is not used by experiences. Returns a removing result
+func (r *ExperienceAPI) RemoveExperienceTypeV1(ctx context.Context, req *desc.RemoveExperienceTypeV1Request) (*desc.RemoveExperienceTypeV1Response, error) {
+	log.Printf("RemoveExperienceTypeV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveExperienceTypeV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeDeleteEvent); err != nil {
+		return nil, err
+	}
+
+	removed, err := r.repo.RemoveType(ctx, req.Id)
+	r.producer.Send(producer.NewEvent(ctx, req.Id, producer.TypeDeleteEvent, err))
+
+	if err != nil {
+		return nil, typeError(err, "RemoveExperienceTypeV1", req.Id)
+	}
+
+	r.metrics.IncRemove(1, "RemoveExperienceTypeV1")
+
+	return &desc.RemoveExperienceTypeV1Response{
+		Removed: removed,
+	}, nil
+}
+
+// ListExperienceLevelsV1 returns the level ladder of experience type
+func (r *ExperienceAPI) ListExperienceLevelsV1(ctx context.Context, req *desc.ListExperienceLevelsV1Request) (*desc.ListExperienceLevelsV1Response, error) {
+	log.Printf("ListExperienceLevelsV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListExperienceLevelsV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeReadEvent); err != nil {
+		return nil, err
+	}
+
+	ladders, err := r.repo.Ladders(ctx, []uint64{req.TypeId})
+	ladder := ladders[req.TypeId]
+
+	// an empty ladder is returned only for types of the catalog
+	if err == nil && len(ladder) == 0 {
+		_, err = r.repo.DescribeType(ctx, req.TypeId)
+	}
+
+	r.producer.Send(producer.NewEvent(ctx, req.TypeId, producer.TypeReadEvent, err))
+
+	if err != nil {
+		return nil, typeError(err, "ListExperienceLevelsV1", req.TypeId)
+	}
+
+	result := make([]*desc.ExperienceLevel, 0, len(ladder))
+
+	for _, level := range ladder {
+		result = append(result, models.ConvertExperienceLevelToAPI(&level))
+	}
+
+	r.metrics.IncList(1, "ListExperienceLevelsV1")
+
+	return &desc.ListExperienceLevelsV1Response{
+		Levels: result,
+	}, nil
+}
+
+// SetExperienceLevelsV1 replaces the level ladder of experience type
+func (r *ExperienceAPI) SetExperienceLevelsV1(ctx context.Context, req *desc.SetExperienceLevelsV1Request) (*desc.SetExperienceLevelsV1Response, error) {
+	log.Printf("SetExperienceLevelsV1 request: %v", req)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SetExperienceLevelsV1")
+	defer span.Finish()
+
+	if err := r.validate(ctx, req, producer.TypeUpdateEvent); err != nil {
+		return nil, err
+	}
+
+	ladder := make(models.Ladder, 0, len(req.Levels))
+
+	for _, level := range req.Levels {
+		ladder = append(ladder, models.ConvertAPIToExperienceLevel(req.TypeId, level))
+	}
+
+	if err := ladder.Validate(); err != nil {
+		r.producer.Send(producer.NewEvent(ctx, req.TypeId, producer.TypeUpdateEvent, err))
+		return nil, invalidArgument(err)
+	}
+
+	err := r.repo.SetLadder(ctx, req.TypeId, ladder)
+	r.producer.Send(producer.NewEvent(ctx, req.TypeId, producer.TypeUpdateEvent, err))
+
+	if err != nil {
+		return nil, typeError(err, "SetExperienceLevelsV1", req.TypeId)
+	}
+
+	r.metrics.IncUpdate(1, "SetExperienceLevelsV1")
+
+	return &desc.SetExperienceLevelsV1Response{}, nil
+}
+
+// typeError converts type catalog error to status, unexpected errors are logged
+func typeError(err error, endpoint string, id uint64) error {
+	switch {
+	case errors.Is(err, repository.TypeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.TypeExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.TypeInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	log.Error().
+		Err(err).
+		Str("endpoint", endpoint).
+		Uint64("id", id).
+		Msgf("Failed to change experience type")
+
+	return err
+}
+
+// setTypeNames sets type name of experiences from the type catalog
+func (r *ExperienceAPI) setTypeNames(ctx context.Context, experiences []*desc.Experience) error {
+	ids := make([]uint64, 0, len(experiences))
+
+	for _, experience := range experiences {
+		ids = append(ids, experience.Type)
+	}
+
+	names, err := r.repo.TypeNames(ctx, ids)
+
+	if err != nil {
+		return err
+	}
+
+	for _, experience := range experiences {
+		experience.TypeName = names[experience.Type]
+	}
+
+	return nil
+}
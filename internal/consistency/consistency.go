@@ -0,0 +1,38 @@
+package consistency
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is a gRPC metadata key requesting reads from the primary database
+const MetadataKey = "x-read-your-writes"
+
+// context key type
+type readYourWritesKey struct{}
+
+// NewContext returns a copy of ctx which reads see previous writes of
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+// ReadYourWrites reports whether reads should go to the primary database,
+// it is requested by NewContext or by a true value in incoming gRPC metadata
+func ReadYourWrites(ctx context.Context) bool {
+	if readYourWrites, ok := ctx.Value(readYourWritesKey{}).(bool); ok {
+		return readYourWrites
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+
+	if len(values) == 0 {
+		return false
+	}
+
+	readYourWrites, _ := strconv.ParseBool(values[0])
+
+	return readYourWrites
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sync"
+
+	"github.com/pressly/goose/v3"
+	"github.com/rs/zerolog/log"
+
+	sql "github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-experience-api/migrations"
+)
+
+// migration modes
+const (
+	MigrateAuto   = "auto"   // applies pending migrations
+	MigrateVerify = "verify" // fails if migrations are pending
+	MigrateOff    = "off"    // skips migrations
+)
+
+// migration dialects
+const (
+	PostgresDialect = "postgres"
+	SQLiteDialect   = "sqlite3"
+)
+
+// migrationLockKey identifies advisory lock held by a replica applying migrations
+const migrationLockKey = 7368421
+
+// gooseMutex guards global goose settings
+var gooseMutex sync.Mutex
+
+// Migrate applies or verifies embedded migrations of dialect according to mode and returns schema version.
+// PostgreSQL migrations are applied under advisory lock, so parallel replicas wait for each other.
+// Returns zero version if mode is off.
+func Migrate(ctx context.Context, db *sql.DB, dialect string, mode string) (int64, error) {
+	switch mode {
+	case MigrateOff:
+		log.Info().Msg("schema migrations are disabled")
+		return 0, nil
+	case MigrateAuto, MigrateVerify:
+	default:
+		return 0, fmt.Errorf("unknown migration mode %q", mode)
+	}
+
+	fsys, dir, err := migrationsFS(dialect)
+
+	if err != nil {
+		return 0, err
+	}
+
+	gooseMutex.Lock()
+	defer gooseMutex.Unlock()
+
+	goose.SetBaseFS(fsys)
+	defer goose.SetBaseFS(nil)
+
+	if err := goose.SetDialect(dialect); err != nil {
+		return 0, err
+	}
+
+	if mode == MigrateVerify {
+		return verifyVersion(db, dir)
+	}
+
+	if dialect == PostgresDialect {
+		unlock, err := lockMigrations(ctx, db)
+
+		if err != nil {
+			return 0, err
+		}
+
+		defer unlock()
+	}
+
+	if err := goose.Up(db.DB, dir); err != nil {
+		return 0, err
+	}
+
+	return goose.GetDBVersion(db.DB)
+}
+
+// returns embedded migrations of dialect and their directory
+func migrationsFS(dialect string) (fs.FS, string, error) {
+	switch dialect {
+	case PostgresDialect:
+		return migrations.Postgres, ".", nil
+	case SQLiteDialect:
+		return migrations.SQLite, "sqlite", nil
+	}
+
+	return nil, "", fmt.Errorf("unknown migration dialect %q", dialect)
+}
+
+// checks that db schema is not behind the last embedded migration
+func verifyVersion(db *sql.DB, dir string) (int64, error) {
+	version, err := goose.GetDBVersion(db.DB)
+
+	if err != nil {
+		return 0, err
+	}
+
+	available, err := goose.CollectMigrations(dir, 0, goose.MaxVersion)
+
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := available.Last()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if version < last.Version {
+		return version, fmt.Errorf("schema version %d is behind migration %d", version, last.Version)
+	}
+
+	return version, nil
+}
+
+// takes migration advisory lock on a dedicated connection and returns its release function
+func lockMigrations(ctx context.Context, db *sql.DB) (func(), error) {
+	conn, err := db.Connx(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			log.Error().Err(err).Msg("failed to release migration lock")
+		}
+
+		_ = conn.Close()
+	}, nil
+}
@@ -0,0 +1,183 @@
+package db_test
+
+import (
+	"context"
+	"io/fs"
+	"net/url"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	sql "github.com/jmoiron/sqlx"
+	"github.com/pressly/goose/v3"
+
+	"github.com/ozoncp/ocp-experience-api/internal/db"
+	"github.com/ozoncp/ocp-experience-api/migrations"
+)
+
+// experienceType is a row of experience_types table
+type experienceType struct {
+	Id   uint64 `db:"id"`
+	Name string `db:"name"`
+}
+
+// expectSeededTypes applies migrations of dialect up to version, stores experiences of types 3 and 7,
+// applies the rest of migrations and checks the types are added to the type catalog
+func expectSeededTypes(ctx context.Context, database *sql.DB, dialect string, fsys fs.FS, dir string, version int64) {
+	goose.SetBaseFS(fsys)
+	Expect(goose.SetDialect(dialect)).To(Succeed())
+	Expect(goose.UpTo(database.DB, dir, version)).To(Succeed())
+	goose.SetBaseFS(nil)
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := database.Exec(database.Rebind(`INSERT INTO experiences (user_id, type, "from", level) VALUES (?, ?, ?, ?), (?, ?, ?, ?), (?, ?, ?, ?)`),
+		1, 7, from, 1,
+		2, 7, from, 1,
+		1, 3, from, 1,
+	)
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = db.Migrate(ctx, database, dialect, db.MigrateAuto)
+	Expect(err).ToNot(HaveOccurred())
+
+	var types []experienceType
+	Expect(database.Select(&types, "SELECT id, name FROM experience_types ORDER BY id")).To(Succeed())
+	Expect(types).To(Equal([]experienceType{{Id: 3, Name: "type 3"}, {Id: 7, Name: "type 7"}}))
+
+	var id uint64
+	Expect(database.Get(&id, "INSERT INTO experience_types (name) VALUES ('backend') RETURNING id")).To(Succeed())
+	Expect(id).To(Equal(uint64(8)))
+}
+
+var _ = Describe("Migrate", func() {
+	var (
+		database *sql.DB
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		database = db.ConnectSQLite(":memory:")
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		_ = database.Close()
+	})
+
+	It("Applies embedded migrations and reports schema version", func() {
+		version, err := db.Migrate(ctx, database, db.SQLiteDialect, db.MigrateAuto)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(int64(4)))
+
+		_, err = database.Exec("SELECT id FROM experiences")
+		Expect(err).ToNot(HaveOccurred())
+
+		version, err = db.Migrate(ctx, database, db.SQLiteDialect, db.MigrateVerify)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(int64(4)))
+	})
+
+	It("Fails verification of not migrated schema", func() {
+		version, err := db.Migrate(ctx, database, db.SQLiteDialect, db.MigrateVerify)
+
+		Expect(err).To(HaveOccurred())
+		Expect(version).To(Equal(int64(0)))
+
+		_, err = database.Exec("SELECT id FROM experiences")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Skips migrations if mode is off", func() {
+		version, err := db.Migrate(ctx, database, db.SQLiteDialect, db.MigrateOff)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(int64(0)))
+
+		_, err = database.Exec("SELECT id FROM experiences")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Adds types used by experiences to the type catalog", func() {
+		expectSeededTypes(ctx, database, db.SQLiteDialect, migrations.SQLite, "sqlite", 2)
+	})
+
+	It("Rejects unknown mode and dialect", func() {
+		_, err := db.Migrate(ctx, database, db.SQLiteDialect, "always")
+		Expect(err).To(HaveOccurred())
+
+		_, err = db.Migrate(ctx, database, "mysql", db.MigrateAuto)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// postgresSchema is a scratch schema PostgreSQL migrations are applied to
+const postgresSchema = "ocp_experience_migrate_test"
+
+var _ = Describe("Migrate PostgreSQL", func() {
+	var (
+		database *sql.DB
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		dsn := os.Getenv("OCP_EXPERIENCE_TEST_DSN")
+
+		if dsn == "" {
+			Skip("OCP_EXPERIENCE_TEST_DSN is not set")
+		}
+
+		ctx = context.Background()
+
+		admin := db.Connect(dsn)
+		defer admin.Close()
+
+		_, err := admin.Exec("DROP SCHEMA IF EXISTS " + postgresSchema + " CASCADE")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = admin.Exec("CREATE SCHEMA " + postgresSchema)
+		Expect(err).ToNot(HaveOccurred())
+
+		schemaDSN, err := url.Parse(dsn)
+		Expect(err).ToNot(HaveOccurred())
+
+		query := schemaDSN.Query()
+		query.Set("search_path", postgresSchema)
+		schemaDSN.RawQuery = query.Encode()
+
+		database = db.Connect(schemaDSN.String())
+	})
+
+	AfterEach(func() {
+		if database == nil {
+			return
+		}
+
+		_, _ = database.Exec("DROP SCHEMA IF EXISTS " + postgresSchema + " CASCADE")
+		_ = database.Close()
+		database = nil
+	})
+
+	It("Applies embedded migrations and reports schema version", func() {
+		version, err := db.Migrate(ctx, database, db.PostgresDialect, db.MigrateAuto)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(int64(12)))
+
+		_, err = database.Exec(`SELECT id, "from", "to" FROM experiences`)
+		Expect(err).ToNot(HaveOccurred())
+
+		version, err = db.Migrate(ctx, database, db.PostgresDialect, db.MigrateVerify)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal(int64(12)))
+	})
+
+	It("Adds types used by experiences to the type catalog", func() {
+		expectSeededTypes(ctx, database, db.PostgresDialect, migrations.Postgres, ".", 10)
+	})
+})
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	sql "github.com/jmoiron/sqlx"
+
+	_ "modernc.org/sqlite"
+)
+
+// sqliteParams enable foreign keys, wait for locks and write times in SQLite format comparable as text
+const sqliteParams = "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_time_format=sqlite"
+
+// ConnectSQLite opens SQLite database by dsn and returns DB instance.
+// The database is used by a single connection, so transactions are serialized.
+// Panics on connection failure.
+func ConnectSQLite(dsn string) *sql.DB {
+	separator := "?"
+
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+
+	db, err := sql.Connect("sqlite", dsn+separator+sqliteParams)
+
+	if err != nil {
+		log.Panic().AnErr("error", err).Msg("failed to open sqlite db")
+	}
+
+	db.SetMaxOpenConns(1)
+
+	return db
+}
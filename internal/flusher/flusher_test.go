@@ -18,7 +18,7 @@ import (
 var _ = Describe("Flusher", func() {
 	var (
 		flusherImpl flusher.Flusher
-		mockRepo    *mocks.MockRepo
+		mockRepo    *mocks.MockIRepo
 		mockCtrl    *gomock.Controller
 		ctx			context.Context
 	)
@@ -26,7 +26,7 @@ var _ = Describe("Flusher", func() {
 	BeforeEach(func() {
 		ctx = context.Background()
 		mockCtrl = gomock.NewController(GinkgoT())
-		mockRepo = mocks.NewMockRepo(mockCtrl)
+		mockRepo = mocks.NewMockIRepo(mockCtrl)
 	})
 
 	AfterEach(func() {
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ozoncp/ocp-experience-api/internal/repo (interfaces: Repo)
+// Source: github.com/ozoncp/ocp-experience-api/internal/repo (interfaces: IRepo)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -7,36 +7,38 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/ozoncp/ocp-experience-api/internal/models"
+	repo "github.com/ozoncp/ocp-experience-api/internal/repo"
 )
 
-// MockRepo is a mock of Repo interface.
-type MockRepo struct {
+// MockIRepo is a mock of IRepo interface.
+type MockIRepo struct {
 	ctrl     *gomock.Controller
-	recorder *MockRepoMockRecorder
+	recorder *MockIRepoMockRecorder
 }
 
-// MockRepoMockRecorder is the mock recorder for MockRepo.
-type MockRepoMockRecorder struct {
-	mock *MockRepo
+// MockIRepoMockRecorder is the mock recorder for MockIRepo.
+type MockIRepoMockRecorder struct {
+	mock *MockIRepo
 }
 
-// NewMockRepo creates a new mock instance.
-func NewMockRepo(ctrl *gomock.Controller) *MockRepo {
-	mock := &MockRepo{ctrl: ctrl}
-	mock.recorder = &MockRepoMockRecorder{mock}
+// NewMockIRepo creates a new mock instance.
+func NewMockIRepo(ctrl *gomock.Controller) *MockIRepo {
+	mock := &MockIRepo{ctrl: ctrl}
+	mock.recorder = &MockIRepoMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
+func (m *MockIRepo) EXPECT() *MockIRepoMockRecorder {
 	return m.recorder
 }
 
 // Add mocks base method.
-func (m *MockRepo) Add(arg0 context.Context, arg1 models.Experience) (uint64, error) {
+func (m *MockIRepo) Add(arg0 context.Context, arg1 models.Experience) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Add", arg0, arg1)
 	ret0, _ := ret[0].(uint64)
@@ -45,13 +47,13 @@ func (m *MockRepo) Add(arg0 context.Context, arg1 models.Experience) (uint64, er
 }
 
 // Add indicates an expected call of Add.
-func (mr *MockRepoMockRecorder) Add(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepoMockRecorder) Add(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockRepo)(nil).Add), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIRepo)(nil).Add), arg0, arg1)
 }
 
 // AddExperiences mocks base method.
-func (m *MockRepo) AddExperiences(arg0 context.Context, arg1 []models.Experience) ([]uint64, error) {
+func (m *MockIRepo) AddExperiences(arg0 context.Context, arg1 []models.Experience) ([]uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddExperiences", arg0, arg1)
 	ret0, _ := ret[0].([]uint64)
@@ -60,13 +62,57 @@ func (m *MockRepo) AddExperiences(arg0 context.Context, arg1 []models.Experience
 }
 
 // AddExperiences indicates an expected call of AddExperiences.
-func (mr *MockRepoMockRecorder) AddExperiences(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepoMockRecorder) AddExperiences(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExperiences", reflect.TypeOf((*MockRepo)(nil).AddExperiences), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExperiences", reflect.TypeOf((*MockIRepo)(nil).AddExperiences), arg0, arg1)
+}
+
+// AddType mocks base method.
+func (m *MockIRepo) AddType(arg0 context.Context, arg1 models.ExperienceType) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddType", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddType indicates an expected call of AddType.
+func (mr *MockIRepoMockRecorder) AddType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddType", reflect.TypeOf((*MockIRepo)(nil).AddType), arg0, arg1)
+}
+
+// CompleteIdempotencyKey mocks base method.
+func (m *MockIRepo) CompleteIdempotencyKey(arg0 context.Context, arg1 models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotencyKey indicates an expected call of CompleteIdempotencyKey.
+func (mr *MockIRepoMockRecorder) CompleteIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyKey", reflect.TypeOf((*MockIRepo)(nil).CompleteIdempotencyKey), arg0, arg1)
+}
+
+// Count mocks base method.
+func (m *MockIRepo) Count(arg0 context.Context, arg1 models.ExperienceFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIRepoMockRecorder) Count(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIRepo)(nil).Count), arg0, arg1)
 }
 
 // Describe mocks base method.
-func (m *MockRepo) Describe(arg0 context.Context, arg1 uint64) (models.Experience, error) {
+func (m *MockIRepo) Describe(arg0 context.Context, arg1 uint64) (models.Experience, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Describe", arg0, arg1)
 	ret0, _ := ret[0].(models.Experience)
@@ -75,51 +121,347 @@ func (m *MockRepo) Describe(arg0 context.Context, arg1 uint64) (models.Experienc
 }
 
 // Describe indicates an expected call of Describe.
-func (mr *MockRepoMockRecorder) Describe(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepoMockRecorder) Describe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockIRepo)(nil).Describe), arg0, arg1)
+}
+
+// DescribeType mocks base method.
+func (m *MockIRepo) DescribeType(arg0 context.Context, arg1 uint64) (models.ExperienceType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeType", arg0, arg1)
+	ret0, _ := ret[0].(models.ExperienceType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeType indicates an expected call of DescribeType.
+func (mr *MockIRepoMockRecorder) DescribeType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeType", reflect.TypeOf((*MockIRepo)(nil).DescribeType), arg0, arg1)
+}
+
+// FindOverlaps mocks base method.
+func (m *MockIRepo) FindOverlaps(arg0 context.Context, arg1, arg2, arg3 uint64) ([]models.Overlap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverlaps", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Overlap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverlaps indicates an expected call of FindOverlaps.
+func (mr *MockIRepoMockRecorder) FindOverlaps(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockRepo)(nil).Describe), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverlaps", reflect.TypeOf((*MockIRepo)(nil).FindOverlaps), arg0, arg1, arg2, arg3)
+}
+
+// IdempotencyKey mocks base method.
+func (m *MockIRepo) IdempotencyKey(arg0 context.Context, arg1, arg2 string) (models.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IdempotencyKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IdempotencyKey indicates an expected call of IdempotencyKey.
+func (mr *MockIRepoMockRecorder) IdempotencyKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IdempotencyKey", reflect.TypeOf((*MockIRepo)(nil).IdempotencyKey), arg0, arg1, arg2)
+}
+
+// Ladders mocks base method.
+func (m *MockIRepo) Ladders(arg0 context.Context, arg1 []uint64) (map[uint64]models.Ladder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ladders", arg0, arg1)
+	ret0, _ := ret[0].(map[uint64]models.Ladder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ladders indicates an expected call of Ladders.
+func (mr *MockIRepoMockRecorder) Ladders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ladders", reflect.TypeOf((*MockIRepo)(nil).Ladders), arg0, arg1)
 }
 
 // List mocks base method.
-func (m *MockRepo) List(arg0 context.Context, arg1, arg2 uint64) ([]models.Experience, error) {
+func (m *MockIRepo) List(arg0 context.Context, arg1 models.ExperienceFilter, arg2 models.ExperienceOrder, arg3 *models.ExperienceCursor, arg4, arg5 uint64) ([]models.Experience, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].([]models.Experience)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockRepoMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockIRepoMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRepo)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ListHistory mocks base method.
+func (m *MockIRepo) ListHistory(arg0 context.Context, arg1, arg2, arg3 uint64) ([]models.ExperienceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.ExperienceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockIRepoMockRecorder) ListHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockIRepo)(nil).ListHistory), arg0, arg1, arg2, arg3)
+}
+
+// ListTypes mocks base method.
+func (m *MockIRepo) ListTypes(arg0 context.Context, arg1, arg2 uint64) ([]models.ExperienceType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTypes", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.ExperienceType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTypes indicates an expected call of ListTypes.
+func (mr *MockIRepoMockRecorder) ListTypes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTypes", reflect.TypeOf((*MockIRepo)(nil).ListTypes), arg0, arg1, arg2)
+}
+
+// Overlapping mocks base method.
+func (m *MockIRepo) Overlapping(arg0 context.Context, arg1 models.Experience) ([]models.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Overlapping", arg0, arg1)
+	ret0, _ := ret[0].([]models.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Overlapping indicates an expected call of Overlapping.
+func (mr *MockIRepoMockRecorder) Overlapping(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Overlapping", reflect.TypeOf((*MockIRepo)(nil).Overlapping), arg0, arg1)
+}
+
+// Purge mocks base method.
+func (m *MockIRepo) Purge(arg0 context.Context, arg1 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockIRepoMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepo)(nil).List), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockIRepo)(nil).Purge), arg0, arg1)
+}
+
+// PurgeIdempotencyKeys mocks base method.
+func (m *MockIRepo) PurgeIdempotencyKeys(arg0 context.Context, arg1 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeIdempotencyKeys", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeIdempotencyKeys indicates an expected call of PurgeIdempotencyKeys.
+func (mr *MockIRepoMockRecorder) PurgeIdempotencyKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeIdempotencyKeys", reflect.TypeOf((*MockIRepo)(nil).PurgeIdempotencyKeys), arg0, arg1)
+}
+
+// ReleaseIdempotencyKey mocks base method.
+func (m *MockIRepo) ReleaseIdempotencyKey(arg0 context.Context, arg1 models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotencyKey indicates an expected call of ReleaseIdempotencyKey.
+func (mr *MockIRepoMockRecorder) ReleaseIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotencyKey", reflect.TypeOf((*MockIRepo)(nil).ReleaseIdempotencyKey), arg0, arg1)
 }
 
 // Remove mocks base method.
-func (m *MockRepo) Remove(arg0 context.Context, arg1 uint64) (bool, error) {
+func (m *MockIRepo) Remove(arg0 context.Context, arg1, arg2 uint64) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", arg0, arg1)
+	ret := m.ctrl.Call(m, "Remove", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockRepoMockRecorder) Remove(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepoMockRecorder) Remove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIRepo)(nil).Remove), arg0, arg1, arg2)
+}
+
+// RemoveExperiences mocks base method.
+func (m *MockIRepo) RemoveExperiences(arg0 context.Context, arg1 []models.Experience) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExperiences", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveExperiences indicates an expected call of RemoveExperiences.
+func (mr *MockIRepoMockRecorder) RemoveExperiences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExperiences", reflect.TypeOf((*MockIRepo)(nil).RemoveExperiences), arg0, arg1)
+}
+
+// RemoveType mocks base method.
+func (m *MockIRepo) RemoveType(arg0 context.Context, arg1 uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveType", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveType indicates an expected call of RemoveType.
+func (mr *MockIRepoMockRecorder) RemoveType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveType", reflect.TypeOf((*MockIRepo)(nil).RemoveType), arg0, arg1)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *MockIRepo) ReserveIdempotencyKey(arg0 context.Context, arg1 models.IdempotencyRecord) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *MockIRepoMockRecorder) ReserveIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockIRepo)(nil).ReserveIdempotencyKey), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockIRepo) Restore(arg0 context.Context, arg1 uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockIRepoMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockIRepo)(nil).Restore), arg0, arg1)
+}
+
+// RunInTx mocks base method.
+func (m *MockIRepo) RunInTx(arg0 context.Context, arg1 func(repo.IRepo) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockIRepoMockRecorder) RunInTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockIRepo)(nil).RunInTx), arg0, arg1)
+}
+
+// SetLadder mocks base method.
+func (m *MockIRepo) SetLadder(arg0 context.Context, arg1 uint64, arg2 models.Ladder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLadder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLadder indicates an expected call of SetLadder.
+func (mr *MockIRepoMockRecorder) SetLadder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLadder", reflect.TypeOf((*MockIRepo)(nil).SetLadder), arg0, arg1, arg2)
+}
+
+// TypeNames mocks base method.
+func (m *MockIRepo) TypeNames(arg0 context.Context, arg1 []uint64) (map[uint64]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeNames", arg0, arg1)
+	ret0, _ := ret[0].(map[uint64]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TypeNames indicates an expected call of TypeNames.
+func (mr *MockIRepoMockRecorder) TypeNames(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockRepo)(nil).Remove), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeNames", reflect.TypeOf((*MockIRepo)(nil).TypeNames), arg0, arg1)
 }
 
 // Update mocks base method.
-func (m *MockRepo) Update(arg0 context.Context, arg1 models.Experience) error {
+func (m *MockIRepo) Update(arg0 context.Context, arg1 models.Experience, arg2 []string, arg3 uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRepoMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIRepoMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIRepo)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// UpdateExperiences mocks base method.
+func (m *MockIRepo) UpdateExperiences(arg0 context.Context, arg1 []models.Experience, arg2 [][]string) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExperiences", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExperiences indicates an expected call of UpdateExperiences.
+func (mr *MockIRepoMockRecorder) UpdateExperiences(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExperiences", reflect.TypeOf((*MockIRepo)(nil).UpdateExperiences), arg0, arg1, arg2)
+}
+
+// UpdateType mocks base method.
+func (m *MockIRepo) UpdateType(arg0 context.Context, arg1 models.ExperienceType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateType", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateType indicates an expected call of UpdateType.
+func (mr *MockIRepoMockRecorder) UpdateType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateType", reflect.TypeOf((*MockIRepo)(nil).UpdateType), arg0, arg1)
+}
+
+// UserExperiences mocks base method.
+func (m *MockIRepo) UserExperiences(arg0 context.Context, arg1 uint64) ([]models.Experience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserExperiences", arg0, arg1)
+	ret0, _ := ret[0].([]models.Experience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserExperiences indicates an expected call of UserExperiences.
+func (mr *MockIRepoMockRecorder) UserExperiences(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepo)(nil).Update), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserExperiences", reflect.TypeOf((*MockIRepo)(nil).UserExperiences), arg0, arg1)
 }
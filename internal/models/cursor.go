@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var InvalidCursor = errors.New("invalid page token")
+
+// ExperienceCursor points to the last experience of a list page.
+// The next page starts right after (sort key, id) pair of that experience
+type ExperienceCursor struct {
+	Order ExperienceOrder
+	Id    uint64
+	Value uint64    // sort key of user_id, type and level orders
+	Time  time.Time // sort key of from and to orders
+}
+
+// cursor token representation
+type cursorToken struct {
+	Field OrderField `json:"f"`
+	Desc  bool       `json:"d,omitempty"`
+	Id    uint64     `json:"i"`
+	Value uint64     `json:"v,omitempty"`
+	Time  *time.Time `json:"t,omitempty"`
+}
+
+// NewCursor creates a cursor pointing to experience in a list sorted by order
+func NewCursor(order ExperienceOrder, experience Experience) ExperienceCursor {
+	cursor := ExperienceCursor{
+		Order: order,
+		Id:    experience.Id,
+	}
+
+	switch order.Field {
+	case OrderByUserId:
+		cursor.Value = experience.UserId
+	case OrderByType:
+		cursor.Value = experience.Type
+	case OrderByLevel:
+		cursor.Value = experience.Level
+	case OrderByFrom:
+		cursor.Time = experience.From
+	case OrderByTo:
+		cursor.Time = experience.To
+	}
+
+	return cursor
+}
+
+// Encode converts cursor to an opaque page token
+func (c ExperienceCursor) Encode() string {
+	token := cursorToken{
+		Field: c.Order.Field,
+		Desc:  c.Order.Desc,
+		Id:    c.Id,
+		Value: c.Value,
+	}
+
+	if c.Order.Field == OrderByFrom || c.Order.Field == OrderByTo {
+		token.Time = &c.Time
+	}
+
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses page token, returns InvalidCursor error on malformed token
+func DecodeCursor(pageToken string) (ExperienceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+
+	if err != nil {
+		return ExperienceCursor{}, InvalidCursor
+	}
+
+	var token cursorToken
+
+	if err := json.Unmarshal(data, &token); err != nil {
+		return ExperienceCursor{}, InvalidCursor
+	}
+
+	if token.Field < OrderById || token.Field > OrderByLevel {
+		return ExperienceCursor{}, InvalidCursor
+	}
+
+	cursor := ExperienceCursor{
+		Order: ExperienceOrder{Field: token.Field, Desc: token.Desc},
+		Id:    token.Id,
+		Value: token.Value,
+	}
+
+	if token.Time != nil {
+		cursor.Time = *token.Time
+	}
+
+	return cursor, nil
+}
@@ -11,12 +11,32 @@ import (
 
 // Experience describes user experience info
 type Experience struct {
-	Id     uint64
-	UserId uint64
-	Type   uint64
-	From   time.Time
-	To     time.Time
-	Level  uint64
+	Id        uint64    `json:"id"`
+	UserId    uint64    `json:"user_id"`
+	Type      uint64    `json:"type"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`         // zero if experience is ongoing
+	Level     uint64    `json:"level"`
+	Version   uint64    `json:"version"`
+	DeletedAt time.Time `json:"deleted_at"` // zero if experience is not removed
+}
+
+// Experience field names which can be updated
+const (
+	UserIdField = "user_id"
+	TypeField   = "type"
+	FromField   = "from"
+	ToField     = "to"
+	LevelField  = "level"
+)
+
+// UpdatableFields is a set of experience fields allowed in partial updates
+var UpdatableFields = map[string]bool{
+	UserIdField: true,
+	TypeField:   true,
+	FromField:   true,
+	ToField:     true,
+	LevelField:  true,
 }
 
 // NewExperience creates Experience new instance
@@ -31,32 +51,57 @@ func NewExperience(id, userId, t uint64, from, to time.Time, level uint64) Exper
 	}
 }
 
+// IsOngoing returns true if experience has no end date
+func (e Experience) IsOngoing() bool {
+	return e.To.IsZero()
+}
+
 // String method converts Experience instance to string representation
 func (e *Experience) String() string {
-	return fmt.Sprintf("Experience{Id : %v, UserId: %v, Type : %v, From : %v, To : %v, Level : %v}",
-		e.Id, e.UserId, e.Type, e.From.String(), e.To.String(), e.Level)
+	return fmt.Sprintf("Experience{Id : %v, UserId: %v, Type : %v, From : %v, To : %v, Level : %v, Version : %v}",
+		e.Id, e.UserId, e.Type, e.From.String(), e.To.String(), e.Level, e.Version)
 }
 
 // ConvertExperienceToAPI converts model.Experience to desc.Experience
 func ConvertExperienceToAPI(experience *Experience) *desc.Experience {
-	return &desc.Experience{
-		Id:     experience.Id,
-		UserId: experience.UserId,
-		Type:   experience.Type,
-		From:   timestamppb.New(experience.From),
-		To:     timestamppb.New(experience.To),
-		Level:  experience.Level,
+	result := &desc.Experience{
+		Id:      experience.Id,
+		UserId:  experience.UserId,
+		Type:    experience.Type,
+		From:    timestamppb.New(experience.From),
+		Level:   experience.Level,
+		Version: experience.Version,
+	}
+
+	if !experience.IsOngoing() {
+		result.To = timestamppb.New(experience.To)
 	}
+
+	if !experience.DeletedAt.IsZero() {
+		result.DeletedAt = timestamppb.New(experience.DeletedAt)
+	}
+
+	return result
 }
 
 // ConvertAPIToExperience converts desc.Experience to model.Experience
 func ConvertAPIToExperience(experience *desc.Experience) Experience {
-	return Experience{
-		Id:     experience.Id,
-		UserId: experience.UserId,
-		Type:   experience.Type,
-		From:   experience.From.AsTime(),
-		To:     experience.To.AsTime(),
-		Level:  experience.Level,
+	result := Experience{
+		Id:      experience.Id,
+		UserId:  experience.UserId,
+		Type:    experience.Type,
+		From:    experience.From.AsTime(),
+		Level:   experience.Level,
+		Version: experience.Version,
+	}
+
+	if experience.To != nil {
+		result.To = experience.To.AsTime()
+	}
+
+	if experience.DeletedAt != nil {
+		result.DeletedAt = experience.DeletedAt.AsTime()
 	}
+
+	return result
 }
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// ExperienceLevel describes a level of experience type ladder, for example junior, middle or senior
+type ExperienceLevel struct {
+	TypeId      uint64
+	Level       uint64
+	Name        string
+	MinDuration time.Duration
+}
+
+// Ladder is an experience type level ladder sorted by level, an empty ladder allows any level
+type Ladder []ExperienceLevel
+
+// Allows reports whether level is on the ladder
+func (l Ladder) Allows(level uint64) bool {
+	if len(l) == 0 {
+		return true
+	}
+
+	for _, experienceLevel := range l {
+		if experienceLevel.Level == level {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks levels and level names are unique and minimum durations are not negative,
+// returns *ValidationError on violations
+func (l Ladder) Validate() error {
+	var violations []FieldViolation
+	levels := make(map[uint64]bool, len(l))
+	names := make(map[string]bool, len(l))
+
+	for index, experienceLevel := range l {
+		if levels[experienceLevel.Level] {
+			violations = append(violations, FieldViolation{fmt.Sprintf("levels[%d].level", index), "must be unique"})
+		}
+
+		if names[experienceLevel.Name] {
+			violations = append(violations, FieldViolation{fmt.Sprintf("levels[%d].name", index), "must be unique"})
+		}
+
+		if experienceLevel.MinDuration < 0 {
+			violations = append(violations, FieldViolation{fmt.Sprintf("levels[%d].min_duration", index), "must not be negative"})
+		}
+
+		levels[experienceLevel.Level] = true
+		names[experienceLevel.Name] = true
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// ConvertExperienceLevelToAPI converts model.ExperienceLevel to desc.ExperienceLevel
+func ConvertExperienceLevelToAPI(level *ExperienceLevel) *desc.ExperienceLevel {
+	return &desc.ExperienceLevel{
+		Level:       level.Level,
+		Name:        level.Name,
+		MinDuration: durationpb.New(level.MinDuration),
+	}
+}
+
+// ConvertAPIToExperienceLevel converts desc.ExperienceLevel of experience type to model.ExperienceLevel
+func ConvertAPIToExperienceLevel(typeId uint64, level *desc.ExperienceLevel) ExperienceLevel {
+	return ExperienceLevel{
+		TypeId:      typeId,
+		Level:       level.Level,
+		Name:        level.Name,
+		MinDuration: level.MinDuration.AsDuration(),
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// ExperienceType describes experience type of the type catalog
+type ExperienceType struct {
+	Id   uint64
+	Name string
+}
+
+// ConvertExperienceTypeToAPI converts model.ExperienceType to desc.ExperienceType
+func ConvertExperienceTypeToAPI(experienceType *ExperienceType) *desc.ExperienceType {
+	return &desc.ExperienceType{
+		Id:   experienceType.Id,
+		Name: experienceType.Name,
+	}
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// OrderField is a field an experience list can be sorted by
+type OrderField int
+
+const (
+	OrderById OrderField = iota
+	OrderByUserId
+	OrderByType
+	OrderByFrom
+	OrderByTo
+	OrderByLevel
+)
+
+// ExperienceFilter describes experience list filter. Zero value fields are not applied
+type ExperienceFilter struct {
+	UserId   uint64
+	Types    []uint64
+	MinLevel uint64
+	MaxLevel uint64
+	From     time.Time // experiences intersecting [From, To] window
+	To       time.Time
+
+	OngoingOnly    bool
+	IncludeDeleted bool
+}
+
+// ExperienceOrder describes experience list sort order
+type ExperienceOrder struct {
+	Field OrderField
+	Desc  bool
+}
+
+// ConvertAPIToFilter converts desc.ExperienceFilter to model.ExperienceFilter
+func ConvertAPIToFilter(filter *desc.ExperienceFilter) ExperienceFilter {
+	if filter == nil {
+		return ExperienceFilter{}
+	}
+
+	result := ExperienceFilter{
+		UserId:   filter.UserId,
+		Types:    filter.Types,
+		MinLevel: filter.MinLevel,
+		MaxLevel: filter.MaxLevel,
+
+		OngoingOnly:    filter.OngoingOnly,
+		IncludeDeleted: filter.IncludeDeleted,
+	}
+
+	if filter.From != nil {
+		result.From = filter.From.AsTime()
+	}
+
+	if filter.To != nil {
+		result.To = filter.To.AsTime()
+	}
+
+	return result
+}
+
+// ConvertAPIToOrder converts desc.ExperienceOrder to model.ExperienceOrder
+func ConvertAPIToOrder(order *desc.ExperienceOrder) ExperienceOrder {
+	if order == nil {
+		return ExperienceOrder{}
+	}
+
+	return ExperienceOrder{
+		Field: OrderField(order.Field),
+		Desc:  order.Desc,
+	}
+}
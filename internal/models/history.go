@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// HistoryAction is a change made to an experience
+type HistoryAction string
+
+const (
+	CreateAction  HistoryAction = "create"
+	UpdateAction  HistoryAction = "update"
+	RemoveAction  HistoryAction = "remove"
+	RestoreAction HistoryAction = "restore"
+)
+
+// history actions in API representation
+var historyActions = map[HistoryAction]desc.ExperienceHistoryRecord_Action{
+	CreateAction:  desc.ExperienceHistoryRecord_CREATE,
+	UpdateAction:  desc.ExperienceHistoryRecord_UPDATE,
+	RemoveAction:  desc.ExperienceHistoryRecord_REMOVE,
+	RestoreAction: desc.ExperienceHistoryRecord_RESTORE,
+}
+
+// ExperienceHistory describes a single experience change.
+// Before is nil for created experiences
+type ExperienceHistory struct {
+	Id           uint64
+	ExperienceId uint64
+	Action       HistoryAction
+	Actor        string
+	Before       *Experience
+	After        *Experience
+	CreatedAt    time.Time
+}
+
+// NewExperienceHistory creates ExperienceHistory of experience changed from before to after state
+func NewExperienceHistory(action HistoryAction, actor string, before, after *Experience) ExperienceHistory {
+	history := ExperienceHistory{
+		Action:    action,
+		Actor:     actor,
+		Before:    before,
+		After:     after,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if after != nil {
+		history.ExperienceId = after.Id
+	} else if before != nil {
+		history.ExperienceId = before.Id
+	}
+
+	return history
+}
+
+// ConvertHistoryToAPI converts model.ExperienceHistory to desc.ExperienceHistoryRecord
+func ConvertHistoryToAPI(history *ExperienceHistory) *desc.ExperienceHistoryRecord {
+	result := &desc.ExperienceHistoryRecord{
+		Id:           history.Id,
+		ExperienceId: history.ExperienceId,
+		Action:       historyActions[history.Action],
+		Actor:        history.Actor,
+		CreatedAt:    timestamppb.New(history.CreatedAt),
+	}
+
+	if history.Before != nil {
+		result.Before = ConvertExperienceToAPI(history.Before)
+	}
+
+	if history.After != nil {
+		result.After = ConvertExperienceToAPI(history.After)
+	}
+
+	return result
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+// IdempotencyRecord is a response of a request with idempotency key stored until it expires
+type IdempotencyRecord struct {
+	Method      string
+	Key         string
+	RequestHash []byte
+	Lease       string // token of the request holding the key while it is in progress
+	Response    []byte // not set while the request is in progress
+	ExpiresAt   time.Time
+}
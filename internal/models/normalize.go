@@ -0,0 +1,66 @@
+package models
+
+import (
+	"sort"
+)
+
+// Adjoins reports whether one experience period ends when the other one starts
+func (e Experience) Adjoins(other Experience) bool {
+	return !e.IsOngoing() && e.To.Equal(other.From) || !other.IsOngoing() && other.To.Equal(e.From)
+}
+
+// Normalize finds experiences of a type overlapping each other or adjacent ones of the same level.
+// Returns experiences to remove and experiences replacing them, each replacing experience covers
+// periods of merged experiences with the highest level
+func Normalize(experiences []Experience) ([]Experience, []Experience) {
+	sorted := make([]Experience, len(experiences))
+	copy(sorted, experiences)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Type != sorted[j].Type {
+			return sorted[i].Type < sorted[j].Type
+		}
+
+		if !sorted[i].From.Equal(sorted[j].From) {
+			return sorted[i].From.Before(sorted[j].From)
+		}
+
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	var removed, created []Experience
+	var group []Experience
+	var union Experience
+
+	// flush replaces the group of merged experiences with their union
+	flush := func() {
+		if len(group) > 1 {
+			union.Id = 0
+			union.Version = 0
+
+			removed = append(removed, group...)
+			created = append(created, union)
+		}
+
+		group = group[:0]
+	}
+
+	for _, experience := range sorted {
+		if len(group) > 0 && union.Type == experience.Type &&
+			(union.Overlaps(experience) || union.Level == experience.Level && union.Adjoins(experience)) {
+			group = append(group, experience)
+			union = union.Merge(experience)
+
+			continue
+		}
+
+		flush()
+
+		group = append(group, experience)
+		union = experience
+	}
+
+	flush()
+
+	return removed, created
+}
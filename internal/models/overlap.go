@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// Overlap is a pair of experiences of the same user and type with overlapping periods
+type Overlap struct {
+	UserId   uint64
+	Type     uint64
+	FirstId  uint64
+	SecondId uint64
+}
+
+// Overlaps reports whether experience periods overlap, ongoing experiences have no end.
+// Adjacent periods do not overlap
+func (e Experience) Overlaps(other Experience) bool {
+	return (e.IsOngoing() || other.From.Before(e.To)) && (other.IsOngoing() || e.From.Before(other.To))
+}
+
+// Merge returns experience with the period covering both experience periods and the maximum level
+func (e Experience) Merge(other Experience) Experience {
+	if other.From.Before(e.From) {
+		e.From = other.From
+	}
+
+	if e.IsOngoing() || other.IsOngoing() {
+		e.To = time.Time{}
+	} else if other.To.After(e.To) {
+		e.To = other.To
+	}
+
+	if other.Level > e.Level {
+		e.Level = other.Level
+	}
+
+	return e
+}
+
+// ConvertOverlapToAPI converts model.Overlap to desc.ExperienceOverlap
+func ConvertOverlapToAPI(overlap *Overlap) *desc.ExperienceOverlap {
+	return &desc.ExperienceOverlap{
+		UserId:   overlap.UserId,
+		Type:     overlap.Type,
+		FirstId:  overlap.FirstId,
+		SecondId: overlap.SecondId,
+	}
+}
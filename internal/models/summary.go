@@ -0,0 +1,110 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	desc "github.com/ozoncp/ocp-experience-api/pkg/ocp-experience-api"
+)
+
+// TypeSummary describes user experience of a type
+type TypeSummary struct {
+	Type      uint64
+	Duration  time.Duration // sum of merged experience intervals
+	MaxLevel  uint64
+	FirstFrom time.Time
+	LastTo    time.Time // zero if ongoing
+	Ongoing   bool
+}
+
+// Summarize returns experience summaries sorted by type. Overlapping experiences of a type are merged,
+// so the time is not counted twice. Ongoing experiences last until now
+func Summarize(experiences []Experience, now time.Time) []TypeSummary {
+	byType := make(map[uint64][]Experience)
+
+	for _, experience := range experiences {
+		byType[experience.Type] = append(byType[experience.Type], experience)
+	}
+
+	summaries := make([]TypeSummary, 0, len(byType))
+
+	for experienceType, typed := range byType {
+		summaries = append(summaries, summarizeType(experienceType, typed, now))
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Type < summaries[j].Type
+	})
+
+	return summaries
+}
+
+// summarizeType summarizes experiences of a type
+func summarizeType(experienceType uint64, experiences []Experience, now time.Time) TypeSummary {
+	summary := TypeSummary{Type: experienceType}
+
+	sort.Slice(experiences, func(i, j int) bool {
+		return experiences[i].From.Before(experiences[j].From)
+	})
+
+	var start, end time.Time
+
+	for index, experience := range experiences {
+		to := experience.To
+
+		if experience.IsOngoing() {
+			to = now
+			summary.Ongoing = true
+		} else if to.After(summary.LastTo) {
+			summary.LastTo = to
+		}
+
+		if experience.Level > summary.MaxLevel {
+			summary.MaxLevel = experience.Level
+		}
+
+		switch {
+		case index == 0:
+			start, end = experience.From, to
+		case experience.From.After(end):
+			summary.Duration += end.Sub(start)
+			start, end = experience.From, to
+		case to.After(end):
+			end = to
+		}
+	}
+
+	if end.After(start) {
+		summary.Duration += end.Sub(start)
+	}
+
+	if len(experiences) > 0 {
+		summary.FirstFrom = experiences[0].From
+	}
+
+	if summary.Ongoing {
+		summary.LastTo = time.Time{}
+	}
+
+	return summary
+}
+
+// ConvertTypeSummaryToAPI converts model.TypeSummary to desc.ExperienceTypeSummary
+func ConvertTypeSummaryToAPI(summary *TypeSummary) *desc.ExperienceTypeSummary {
+	result := &desc.ExperienceTypeSummary{
+		Type:      summary.Type,
+		Duration:  durationpb.New(summary.Duration),
+		MaxLevel:  summary.MaxLevel,
+		FirstFrom: timestamppb.New(summary.FirstFrom),
+		Ongoing:   summary.Ongoing,
+	}
+
+	if !summary.Ongoing {
+		result.LastTo = timestamppb.New(summary.LastTo)
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// experience type and level lower bounds, upper bounds are set by the type catalog
+const (
+	MinType  = 1
+	MinLevel = 1
+)
+
+// ExperienceFields are experience fields checked by Validate
+var ExperienceFields = []string{TypeField, FromField, ToField, LevelField}
+
+// FieldViolation describes an experience field violating domain rules
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError contains all experience field violations
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Error joins field violations
+func (e *ValidationError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		violations = append(violations, violation.Field+": "+violation.Description)
+	}
+
+	return "invalid experience: " + strings.Join(violations, "; ")
+}
+
+// Validate checks experience domain rules, returns *ValidationError on violations
+func (e Experience) Validate() error {
+	return e.ValidateFields(ExperienceFields)
+}
+
+// ValidateFields checks domain rules of experience fields, returns *ValidationError on violations.
+// From is required and must not be in the future, to is optional for ongoing experiences and must be after from.
+// Interval order is checked only if both from and to are listed
+func (e Experience) ValidateFields(fields []string) error {
+	var violations []FieldViolation
+	listed := make(map[string]bool, len(fields))
+
+	for _, field := range fields {
+		listed[field] = true
+	}
+
+	if listed[TypeField] && e.Type < MinType {
+		violations = append(violations, FieldViolation{TypeField, fmt.Sprintf("must be at least %d", MinType)})
+	}
+
+	if listed[LevelField] && e.Level < MinLevel {
+		violations = append(violations, FieldViolation{LevelField, fmt.Sprintf("must be at least %d", MinLevel)})
+	}
+
+	if listed[FromField] {
+		if e.From.IsZero() {
+			violations = append(violations, FieldViolation{FromField, "is required"})
+		} else if e.From.After(time.Now()) {
+			violations = append(violations, FieldViolation{FromField, "must not be in the future"})
+		}
+	}
+
+	if listed[FromField] && listed[ToField] && !e.From.IsZero() && !e.IsOngoing() && !e.From.Before(e.To) {
+		violations = append(violations, FieldViolation{ToField, "must be after from"})
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+
+	return nil
+}
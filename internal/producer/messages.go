@@ -19,6 +19,11 @@ const (
 	ReadEvent
 	UpdateEvent
 	DeleteEvent
+	// experience type catalog events, request id is a type id
+	TypeCreateEvent
+	TypeReadEvent
+	TypeUpdateEvent
+	TypeDeleteEvent
 )
 
 type EventMsg interface {
@@ -37,7 +42,7 @@ func NewEvent(ctx context.Context, requestId uint64, eventType EventType, err er
 
 	if span != nil {
 		if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, spanDump); err != nil {
-			log.Warn().Msgf("failed to update event message with span info", err)
+			log.Warn().Msgf("failed to update event message with span info: %v", err)
 		} else {
 			e.span = spanDump
 		}
@@ -69,18 +74,23 @@ func (e *event) Encode() ([]byte, error) {
 	}
 
 	switch e.eventType {
-	case CreateEvent:
+	case CreateEvent, TypeCreateEvent:
 		message.Event = desc.ExperienceAPIEvent_CREATE
-	case ReadEvent:
+	case ReadEvent, TypeReadEvent:
 		message.Event = desc.ExperienceAPIEvent_READ
-	case UpdateEvent:
+	case UpdateEvent, TypeUpdateEvent:
 		message.Event = desc.ExperienceAPIEvent_UPDATE
-	case DeleteEvent:
+	case DeleteEvent, TypeDeleteEvent:
 		message.Event = desc.ExperienceAPIEvent_DELETE
 	default:
 		log.Panic().Msgf("unexpected event type: %v", e.eventType)
 	}
 
+	switch e.eventType {
+	case TypeCreateEvent, TypeReadEvent, TypeUpdateEvent, TypeDeleteEvent:
+		message.Entity = desc.ExperienceAPIEvent_EXPERIENCE_TYPE
+	}
+
 	if len(e.span) > 0 {
 		message.TraceSpan = e.span
 	}
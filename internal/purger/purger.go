@@ -0,0 +1,109 @@
+package purger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-experience-api/internal/repo"
+)
+
+// Purger periodically deletes removed experiences from storage once their retention period is over,
+// and expired idempotency keys on its own schedule.
+// Init() must be called before using an instance. Close() to stop purging.
+type Purger interface {
+	Init()
+	Close()
+}
+
+// NewPurger creates Purger instance. Every interval it deletes experiences which were removed more than
+// retention ago, every keyInterval it deletes expired idempotency keys. Zero interval disables its purging
+func NewPurger(repo repo.IRepo, retention, interval, keyInterval time.Duration) Purger {
+	return &purger{
+		repo:        repo,
+		retention:   retention,
+		interval:    interval,
+		keyInterval: keyInterval,
+		closeChan:   make(chan struct{}),
+		doneChan:    make(chan struct{}),
+	}
+}
+
+// Implements Purger interface
+type purger struct {
+	repo        repo.IRepo
+	retention   time.Duration
+	interval    time.Duration
+	keyInterval time.Duration
+	closeChan   chan struct{}
+	doneChan    chan struct{}
+}
+
+// Init starts purging in background
+func (p *purger) Init() {
+	go p.run()
+}
+
+// Close stops purging and waits for the running purge to finish
+func (p *purger) Close() {
+	close(p.closeChan)
+	<-p.doneChan
+}
+
+// main loop
+func (p *purger) run() {
+	defer close(p.doneChan)
+
+	experienceTicks, stopExperienceTicks := tick(p.interval)
+	defer stopExperienceTicks()
+
+	keyTicks, stopKeyTicks := tick(p.keyInterval)
+	defer stopKeyTicks()
+
+	for {
+		select {
+		case <-experienceTicks:
+			p.purgeExperiences()
+
+		case <-keyTicks:
+			p.purgeIdempotencyKeys()
+
+		case <-p.closeChan:
+			return
+		}
+	}
+}
+
+// tick returns channel ticking every interval and its stop function, the channel of zero interval never ticks
+func tick(interval time.Duration) (<-chan time.Time, func()) {
+	if interval == 0 {
+		return nil, func() {}
+	}
+
+	ticker := time.NewTicker(interval)
+	return ticker.C, ticker.Stop
+}
+
+// purgeExperiences deletes experiences removed before retention period
+func (p *purger) purgeExperiences() {
+	deletedBefore := time.Now().Add(-p.retention)
+	purged, err := p.repo.Purge(context.Background(), deletedBefore)
+
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to purge removed experiences")
+	} else if purged > 0 {
+		log.Info().Uint64("purged", purged).Msgf("Purged removed experiences")
+	}
+}
+
+// purgeIdempotencyKeys deletes expired idempotency keys
+func (p *purger) purgeIdempotencyKeys() {
+	expired, err := p.repo.PurgeIdempotencyKeys(context.Background(), time.Now())
+
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to purge expired idempotency keys")
+	} else if expired > 0 {
+		log.Info().Uint64("purged", expired).Msgf("Purged expired idempotency keys")
+	}
+}
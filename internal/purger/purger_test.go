@@ -0,0 +1,128 @@
+package purger_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ozoncp/ocp-experience-api/internal/mocks/mocks"
+	"github.com/ozoncp/ocp-experience-api/internal/purger"
+)
+
+var _ = Describe("Purger", func() {
+	const interval = time.Millisecond * 10
+
+	var (
+		mockRepo *mocks.MockIRepo
+		mockCtrl *gomock.Controller
+		purged   chan struct{}
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockIRepo(mockCtrl)
+		purged = make(chan struct{}, 1)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	// signal notifies the test without blocking later ticks
+	signal := func() {
+		select {
+		case purged <- struct{}{}:
+		default:
+		}
+	}
+
+	// allowNextTicks lets ticks following the checked ones purge nothing
+	allowNextTicks := func() {
+		mockRepo.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+		mockRepo.EXPECT().PurgeIdempotencyKeys(gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	}
+
+	It("Purges experiences removed before retention period and expired idempotency keys on tick", func() {
+		retention := time.Hour
+		start := time.Now()
+		keysPurged := make(chan struct{}, 1)
+
+		mockRepo.EXPECT().
+			Purge(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, deletedBefore time.Time) (uint64, error) {
+				Expect(deletedBefore).To(BeTemporally("~", start.Add(-retention), time.Second))
+				signal()
+				return 1, nil
+			}).
+			Times(1)
+
+		mockRepo.EXPECT().
+			PurgeIdempotencyKeys(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, expiredBefore time.Time) (uint64, error) {
+				Expect(expiredBefore).To(BeTemporally("~", start, time.Second))
+				keysPurged <- struct{}{}
+				return 1, nil
+			}).
+			Times(1)
+
+		allowNextTicks()
+
+		p := purger.NewPurger(mockRepo, retention, interval, interval)
+		p.Init()
+
+		Eventually(purged, time.Second).Should(Receive())
+		Eventually(keysPurged, time.Second).Should(Receive())
+		p.Close()
+	})
+
+	It("Keeps purging after errors", func() {
+		gomock.InOrder(
+			mockRepo.EXPECT().
+				Purge(gomock.Any(), gomock.Any()).
+				Return(uint64(0), errors.New("test error")),
+			mockRepo.EXPECT().
+				Purge(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ interface{}, _ time.Time) (uint64, error) {
+					signal()
+					return 0, nil
+				}),
+		)
+
+		allowNextTicks()
+
+		p := purger.NewPurger(mockRepo, time.Hour, interval, 0)
+		p.Init()
+
+		Eventually(purged, time.Second).Should(Receive())
+		p.Close()
+	})
+
+	It("Purges expired idempotency keys if experiences purging is disabled", func() {
+		gomock.InOrder(
+			mockRepo.EXPECT().
+				PurgeIdempotencyKeys(gomock.Any(), gomock.Any()).
+				Return(uint64(0), errors.New("test error")),
+			mockRepo.EXPECT().
+				PurgeIdempotencyKeys(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ interface{}, _ time.Time) (uint64, error) {
+					signal()
+					return 0, nil
+				}),
+		)
+
+		mockRepo.EXPECT().
+			PurgeIdempotencyKeys(gomock.Any(), gomock.Any()).
+			Return(uint64(0), nil).
+			AnyTimes()
+
+		p := purger.NewPurger(mockRepo, time.Hour, 0, interval)
+		p.Init()
+
+		Eventually(purged, time.Second).Should(Receive())
+		p.Close()
+	})
+})
@@ -0,0 +1,59 @@
+package repo_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/ozoncp/ocp-experience-api/internal/db"
+	"github.com/ozoncp/ocp-experience-api/internal/repo"
+	"github.com/ozoncp/ocp-experience-api/internal/repo/repotest"
+)
+
+// postgresDSNEnv names environment variable with DSN of a test database,
+// it is migrated and its tables are truncated by conformance cases
+const postgresDSNEnv = "OCP_EXPERIENCE_TEST_DSN"
+
+func TestMemoryRepoConformance(t *testing.T) {
+	repotest.RunConformance(t, func(*testing.T) repo.IRepo {
+		return repo.NewMemoryRepo()
+	})
+}
+
+func TestRepoConformance(t *testing.T) {
+	dsn := os.Getenv(postgresDSNEnv)
+
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDSNEnv)
+	}
+
+	database := db.Connect(dsn)
+	defer database.Close()
+
+	if _, err := db.Migrate(context.Background(), database, db.PostgresDialect, db.MigrateAuto); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	repotest.RunConformance(t, func(t *testing.T) repo.IRepo {
+		_, err := database.Exec("TRUNCATE experiences, experience_history, experience_types, experience_levels, idempotency_keys RESTART IDENTITY")
+
+		if err != nil {
+			t.Fatalf("failed to truncate tables: %v", err)
+		}
+
+		return repo.NewRepo(database)
+	})
+}
+
+func TestSQLiteRepoConformance(t *testing.T) {
+	repotest.RunConformance(t, func(t *testing.T) repo.IRepo {
+		database := db.ConnectSQLite(":memory:")
+		t.Cleanup(func() { database.Close() })
+
+		if _, err := db.Migrate(context.Background(), database, db.SQLiteDialect, db.MigrateAuto); err != nil {
+			t.Fatalf("failed to migrate: %v", err)
+		}
+
+		return repo.NewSQLiteRepo(database)
+	})
+}
@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+// experience history columns to select
+const historyColumns = "id, experience_id, action, actor, before, after, created_at"
+
+// ListHistory returns changes of experience by id, the oldest change first
+func (r *Repo) ListHistory(ctx context.Context, experienceId, limit, offset uint64) ([]models.ExperienceHistory, error) {
+	query := r.reader(ctx).Select(historyColumns).
+		From("experience_history").
+		Where("experience_id = ?", experienceId).
+		OrderBy("id ASC").
+		Offset(offset).
+		Limit(limit)
+
+	rows, err := query.QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	records := make([]models.ExperienceHistory, 0, limit)
+
+	for rows.Next() {
+		record, scanErr := scanHistory(rows)
+
+		if scanErr != nil {
+			return nil, scanErr
+		}
+
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// addHistory writes experience changes records
+func addHistory(ctx context.Context, builder sq.StatementBuilderType, records []models.ExperienceHistory) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	query := builder.Insert("experience_history").
+		Columns("experience_id", "action", "actor", "before", "after", "created_at")
+
+	for _, record := range records {
+		before, err := snapshot(record.Before)
+
+		if err != nil {
+			return err
+		}
+
+		after, err := snapshot(record.After)
+
+		if err != nil {
+			return err
+		}
+
+		query = query.Values(record.ExperienceId, string(record.Action), record.Actor, before, after, record.CreatedAt)
+	}
+
+	_, err := query.ExecContext(ctx)
+	return err
+}
+
+// snapshot encodes experience state, nil experience is encoded as NULL
+func snapshot(experience *models.Experience) (interface{}, error) {
+	if experience == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(experience)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// scanHistory reads historyColumns of a row
+func scanHistory(row scanner) (models.ExperienceHistory, error) {
+	var record models.ExperienceHistory
+	var action string
+	var before, after []byte
+
+	err := row.Scan(&record.Id, &record.ExperienceId, &action, &record.Actor, &before, &after, &record.CreatedAt)
+
+	if err != nil {
+		return models.ExperienceHistory{}, err
+	}
+
+	record.Action = models.HistoryAction(action)
+
+	if record.Before, err = decodeSnapshot(before); err != nil {
+		return models.ExperienceHistory{}, err
+	}
+
+	if record.After, err = decodeSnapshot(after); err != nil {
+		return models.ExperienceHistory{}, err
+	}
+
+	return record, nil
+}
+
+// decodeSnapshot decodes experience state, NULL is decoded as nil experience
+func decodeSnapshot(data []byte) (*models.Experience, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var experience models.Experience
+
+	if err := json.Unmarshal(data, &experience); err != nil {
+		return nil, err
+	}
+
+	return &experience, nil
+}
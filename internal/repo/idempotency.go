@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+var IdempotencyKeyNotFound = errors.New("idempotency key does not exist")
+var IdempotencyLeaseLost = errors.New("idempotency key is not reserved by the request")
+
+// ReserveIdempotencyKey stores idempotency record without response, record ExpiresAt is a lease of the request
+// in progress held by record Lease token. Returns false if the key of the method is already stored and has not expired,
+// an expired record or an in progress record with an expired lease is replaced
+func (r *Repo) ReserveIdempotencyKey(ctx context.Context, record models.IdempotencyRecord) (bool, error) {
+	rows, err := r.builder.Insert("idempotency_keys").
+		Columns("method", "key", "request_hash", "lease", "expires_at").
+		Values(record.Method, record.Key, record.RequestHash, record.Lease, record.ExpiresAt.UTC()).
+		Suffix("ON CONFLICT (method, key) DO UPDATE SET "+
+			"request_hash = EXCLUDED.request_hash, lease = EXCLUDED.lease, response = NULL, expires_at = EXCLUDED.expires_at "+
+			"WHERE idempotency_keys.expires_at <= ? RETURNING key", time.Now().UTC()).
+		QueryContext(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rows.Close()
+
+	reserved := rows.Next()
+	return reserved, rows.Err()
+}
+
+// IdempotencyKey returns idempotency record of the method by key, returns IdempotencyKeyNotFound error
+// if there is none or it is expired
+func (r *Repo) IdempotencyKey(ctx context.Context, method, key string) (models.IdempotencyRecord, error) {
+	rows, err := r.builder.Select("request_hash", "response", "expires_at").
+		From("idempotency_keys").
+		Where("method = ? AND key = ? AND expires_at > ?", method, key, time.Now().UTC()).
+		QueryContext(ctx)
+
+	if err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return models.IdempotencyRecord{}, err
+		}
+
+		return models.IdempotencyRecord{}, IdempotencyKeyNotFound
+	}
+
+	record := models.IdempotencyRecord{Method: method, Key: key}
+
+	if err := rows.Scan(&record.RequestHash, &record.Response, &record.ExpiresAt); err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+
+	return record, nil
+}
+
+// CompleteIdempotencyKey stores record Response until record ExpiresAt if the key is still reserved by record Lease
+// and the lease has not expired. Returns IdempotencyLeaseLost error otherwise
+func (r *Repo) CompleteIdempotencyKey(ctx context.Context, record models.IdempotencyRecord) error {
+	ret, err := r.builder.Update("idempotency_keys").
+		Set("response", record.Response).
+		Set("expires_at", record.ExpiresAt.UTC()).
+		Where("method = ? AND key = ? AND lease = ? AND response IS NULL AND expires_at > ?",
+			record.Method, record.Key, record.Lease, time.Now().UTC()).
+		ExecContext(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	rowsUpdated, err := ret.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rowsUpdated == 0 {
+		return IdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey deletes idempotency record without response reserved by record Lease,
+// so the request may be retried with the key. A key reserved by another request is kept
+func (r *Repo) ReleaseIdempotencyKey(ctx context.Context, record models.IdempotencyRecord) error {
+	_, err := r.builder.Delete("idempotency_keys").
+		Where("method = ? AND key = ? AND lease = ? AND response IS NULL", record.Method, record.Key, record.Lease).
+		ExecContext(ctx)
+
+	return err
+}
+
+// PurgeIdempotencyKeys deletes idempotency records expired before time, returns number of deleted records
+func (r *Repo) PurgeIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (uint64, error) {
+	ret, err := r.builder.Delete("idempotency_keys").
+		Where("expires_at <= ?", expiredBefore.UTC()).
+		ExecContext(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	rowsDeleted, err := ret.RowsAffected()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(rowsDeleted), nil
+}
@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+// Ladders returns level ladders of experience types sorted by level, types without ladders are not returned
+func (r *Repo) Ladders(ctx context.Context, typeIds []uint64) (map[uint64]models.Ladder, error) {
+	ladders := make(map[uint64]models.Ladder, len(typeIds))
+
+	if len(typeIds) == 0 {
+		return ladders, nil
+	}
+
+	rows, err := r.builder.Select("type_id, level, name, min_duration_seconds").
+		From("experience_levels").
+		Where(sq.Eq{"type_id": typeIds}).
+		OrderBy("type_id ASC", "level ASC").
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var level models.ExperienceLevel
+		var minDurationSeconds int64
+
+		if err := rows.Scan(&level.TypeId, &level.Level, &level.Name, &minDurationSeconds); err != nil {
+			return nil, err
+		}
+
+		level.MinDuration = time.Duration(minDurationSeconds) * time.Second
+		ladders[level.TypeId] = append(ladders[level.TypeId], level)
+	}
+
+	return ladders, rows.Err()
+}
+
+// SetLadder replaces the level ladder of experience type. Returns TypeNotFound error if there is no type
+func (r *Repo) SetLadder(ctx context.Context, typeId uint64, ladder models.Ladder) error {
+	return r.inTx(ctx, func(tx sq.BaseRunner) error {
+		builder := r.builder.RunWith(tx)
+
+		selectType := builder.Select("id").
+			From("experience_types").
+			Where("id = ?", typeId)
+
+		rows, err := r.forUpdate(selectType).QueryContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		found, err := exists(rows)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return TypeNotFound
+		}
+
+		if _, err := builder.Delete("experience_levels").
+			Where("type_id = ?", typeId).
+			ExecContext(ctx); err != nil {
+			return err
+		}
+
+		if len(ladder) == 0 {
+			return nil
+		}
+
+		query := builder.Insert("experience_levels").
+			Columns("type_id", "level", "name", "min_duration_seconds")
+
+		for _, level := range ladder {
+			query = query.Values(typeId, level.Level, level.Name, int64(level.MinDuration/time.Second))
+		}
+
+		_, err = query.ExecContext(ctx)
+		return err
+	})
+}
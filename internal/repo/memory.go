@@ -0,0 +1,1131 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ozoncp/ocp-experience-api/internal/actor"
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+// NewMemoryRepo creates an empty MemoryRepo
+func NewMemoryRepo() *MemoryRepo {
+	return &MemoryRepo{
+		store: &memoryStore{state: newMemoryState()},
+	}
+}
+
+// MemoryRepo is IRepo impl keeping data in memory, it is safe for concurrent use.
+// Writes and transactions are serialized and change data in place, changes of a failed write
+// or a rolled back transaction are reverted by an undo log
+type MemoryRepo struct {
+	store *memoryStore
+	tx    *memoryState // set if repo is bound to a transaction
+}
+
+// memoryStore guards committed data
+type memoryStore struct {
+	mu    sync.RWMutex
+	state *memoryState
+}
+
+// memoryState is stored data changed in place by writes. Every map value change logs a function restoring
+// the previous value to the undo log, a write takes a savepoint before it starts and rolls back to it by running
+// the log backwards and truncating history and last ids. The log is cleared when a write or transaction succeeds
+type memoryState struct {
+	experiences map[uint64]models.Experience
+	history     []models.ExperienceHistory
+	types       map[uint64]models.ExperienceType
+	ladders     map[uint64]models.Ladder
+	keys        map[idempotencyKey]models.IdempotencyRecord
+
+	lastExperienceId uint64
+	lastHistoryId    uint64
+	lastTypeId       uint64
+
+	undo []func() // restores map values changed by the running write
+}
+
+// memorySavepoint is a state to roll a write back to, map values are restored by the undo log
+type memorySavepoint struct {
+	historyLen       int
+	lastExperienceId uint64
+	lastHistoryId    uint64
+	lastTypeId       uint64
+}
+
+// idempotencyKey identifies idempotency record
+type idempotencyKey struct {
+	method string
+	key    string
+}
+
+// newMemoryState creates empty memoryState
+func newMemoryState() *memoryState {
+	return &memoryState{
+		experiences: make(map[uint64]models.Experience),
+		types:       make(map[uint64]models.ExperienceType),
+		ladders:     make(map[uint64]models.Ladder),
+		keys:        make(map[idempotencyKey]models.IdempotencyRecord),
+	}
+}
+
+// savepoint starts a write and returns the state to roll it back to
+func (s *memoryState) savepoint() memorySavepoint {
+	s.undo = s.undo[:0]
+
+	return memorySavepoint{
+		historyLen:       len(s.history),
+		lastExperienceId: s.lastExperienceId,
+		lastHistoryId:    s.lastHistoryId,
+		lastTypeId:       s.lastTypeId,
+	}
+}
+
+// rollback reverts changes made since savepoint, history is appended only so it is truncated
+func (s *memoryState) rollback(savepoint memorySavepoint) {
+	for index := len(s.undo) - 1; index >= 0; index-- {
+		s.undo[index]()
+	}
+
+	s.undo = s.undo[:0]
+	s.history = s.history[:savepoint.historyLen]
+	s.lastExperienceId = savepoint.lastExperienceId
+	s.lastHistoryId = savepoint.lastHistoryId
+	s.lastTypeId = savepoint.lastTypeId
+}
+
+// setExperience stores experience by its id
+func (s *memoryState) setExperience(experience models.Experience) {
+	s.rememberExperience(experience.Id)
+	s.experiences[experience.Id] = experience
+}
+
+// deleteExperience deletes experience by id
+func (s *memoryState) deleteExperience(id uint64) {
+	s.rememberExperience(id)
+	delete(s.experiences, id)
+}
+
+// rememberExperience logs restoring of experience by id
+func (s *memoryState) rememberExperience(id uint64) {
+	previous, ok := s.experiences[id]
+
+	s.undo = append(s.undo, func() {
+		if ok {
+			s.experiences[id] = previous
+		} else {
+			delete(s.experiences, id)
+		}
+	})
+}
+
+// setType stores experience type by its id
+func (s *memoryState) setType(experienceType models.ExperienceType) {
+	s.rememberType(experienceType.Id)
+	s.types[experienceType.Id] = experienceType
+}
+
+// deleteType deletes experience type with its ladder by id
+func (s *memoryState) deleteType(id uint64) {
+	s.rememberType(id)
+	delete(s.types, id)
+	s.setLadder(id, nil)
+}
+
+// rememberType logs restoring of experience type by id
+func (s *memoryState) rememberType(id uint64) {
+	previous, ok := s.types[id]
+
+	s.undo = append(s.undo, func() {
+		if ok {
+			s.types[id] = previous
+		} else {
+			delete(s.types, id)
+		}
+	})
+}
+
+// setLadder stores level ladder of experience type, an empty ladder is deleted
+func (s *memoryState) setLadder(typeId uint64, ladder models.Ladder) {
+	previous, ok := s.ladders[typeId]
+
+	s.undo = append(s.undo, func() {
+		if ok {
+			s.ladders[typeId] = previous
+		} else {
+			delete(s.ladders, typeId)
+		}
+	})
+
+	if len(ladder) == 0 {
+		delete(s.ladders, typeId)
+	} else {
+		s.ladders[typeId] = ladder
+	}
+}
+
+// setKey stores idempotency record by key
+func (s *memoryState) setKey(key idempotencyKey, record models.IdempotencyRecord) {
+	s.rememberKey(key)
+	s.keys[key] = record
+}
+
+// deleteKey deletes idempotency record by key
+func (s *memoryState) deleteKey(key idempotencyKey) {
+	s.rememberKey(key)
+	delete(s.keys, key)
+}
+
+// rememberKey logs restoring of idempotency record by key
+func (s *memoryState) rememberKey(key idempotencyKey) {
+	previous, ok := s.keys[key]
+
+	s.undo = append(s.undo, func() {
+		if ok {
+			s.keys[key] = previous
+		} else {
+			delete(s.keys, key)
+		}
+	})
+}
+
+// read runs fn with stored data
+func (r *MemoryRepo) read(fn func(s *memoryState) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return fn(r.store.state)
+}
+
+// write runs fn with stored data, changes of fn are rolled back if it fails.
+// If repo is bound to a transaction, fn changes the transaction data
+func (r *MemoryRepo) write(fn func(s *memoryState) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	state := r.store.state
+	savepoint := state.savepoint()
+
+	if err := fn(state); err != nil {
+		state.rollback(savepoint)
+		return err
+	}
+
+	state.undo = state.undo[:0]
+	return nil
+}
+
+// RunInTx runs fn in a transaction, fn should use tx repo to take part in the transaction.
+// The transaction is rolled back if fn returns an error. If repo is already bound to a transaction, fn joins it
+func (r *MemoryRepo) RunInTx(_ context.Context, fn func(tx IRepo) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	return r.write(func(s *memoryState) error {
+		return fn(&MemoryRepo{store: r.store, tx: s})
+	})
+}
+
+// Add adds experience and returns its id
+func (r *MemoryRepo) Add(ctx context.Context, experience models.Experience) (uint64, error) {
+	ids, err := r.AddExperiences(ctx, []models.Experience{experience})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return ids[0], nil
+}
+
+// AddExperiences adds experience slice. Returns TypeNotFound error if a type is missing in the type catalog
+func (r *MemoryRepo) AddExperiences(ctx context.Context, experiences []models.Experience) ([]uint64, error) {
+	newIds := make([]uint64, 0, len(experiences))
+
+	err := r.write(func(s *memoryState) error {
+		caller := actor.FromContext(ctx)
+
+		for _, experience := range experiences {
+			if _, ok := s.types[experience.Type]; !ok {
+				return TypeNotFound
+			}
+
+			s.lastExperienceId++
+
+			created := experience
+			created.Id = s.lastExperienceId
+			created.Version = 1
+			created.DeletedAt = time.Time{}
+
+			s.setExperience(created)
+			s.addHistory(models.NewExperienceHistory(models.CreateAction, caller, nil, &created))
+			newIds = append(newIds, created.Id)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newIds, nil
+}
+
+// List returns an experience list matching filter sorted by order.
+// If after is set, the list starts right after the cursor position
+func (r *MemoryRepo) List(_ context.Context, filter models.ExperienceFilter, order models.ExperienceOrder, after *models.ExperienceCursor, limit, offset uint64) ([]models.Experience, error) {
+	var experiences []models.Experience
+
+	err := r.read(func(s *memoryState) error {
+		var cursor models.Experience
+
+		if after != nil {
+			cursor = cursorExperience(order, after)
+		}
+
+		for _, experience := range s.experiences {
+			if !matchFilter(experience, filter) {
+				continue
+			}
+
+			if after != nil && !less(order, cursor, experience) {
+				continue
+			}
+
+			experiences = append(experiences, experience)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(experiences, func(i, j int) bool {
+		return less(order, experiences[i], experiences[j])
+	})
+
+	start, end := pageBounds(len(experiences), limit, offset)
+	return experiences[start:end], nil
+}
+
+// Count returns number of experiences matching filter
+func (r *MemoryRepo) Count(_ context.Context, filter models.ExperienceFilter) (uint64, error) {
+	var count uint64 = 0
+
+	err := r.read(func(s *memoryState) error {
+		for _, experience := range s.experiences {
+			if matchFilter(experience, filter) {
+				count++
+			}
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// Describe returns experience by id, removed experiences are not returned
+func (r *MemoryRepo) Describe(_ context.Context, id uint64) (models.Experience, error) {
+	var experience models.Experience
+
+	err := r.read(func(s *memoryState) error {
+		var ok bool
+		experience, ok = s.experiences[id]
+
+		if !ok || !experience.DeletedAt.IsZero() {
+			return NotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return models.Experience{}, err
+	}
+
+	return experience, nil
+}
+
+// Remove marks experience as deleted by id, it can be restored until purged.
+// If expectedVersion is set, returns VersionMismatch error if experience has another version
+func (r *MemoryRepo) Remove(ctx context.Context, id, expectedVersion uint64) (bool, error) {
+	err := r.write(func(s *memoryState) error {
+		return s.change(ctx, models.RemoveAction, removeChange, id, expectedVersion)
+	})
+
+	if errors.Is(err, NotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Restore unmarks removed experience by id
+func (r *MemoryRepo) Restore(ctx context.Context, id uint64) (bool, error) {
+	restore := func(experience *models.Experience) {
+		experience.DeletedAt = time.Time{}
+	}
+
+	err := r.write(func(s *memoryState) error {
+		return s.change(ctx, models.RestoreAction, restore, id, 0)
+	})
+
+	if errors.Is(err, NotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Purge deletes experiences removed before deletedBefore, returns number of deleted experiences
+func (r *MemoryRepo) Purge(_ context.Context, deletedBefore time.Time) (uint64, error) {
+	var purged uint64 = 0
+
+	err := r.write(func(s *memoryState) error {
+		for id, experience := range s.experiences {
+			if !experience.DeletedAt.IsZero() && experience.DeletedAt.Before(deletedBefore) {
+				s.deleteExperience(id)
+				purged++
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// Update updates existing experience and bumps its version, returns NotFound error if request does not exist.
+// Only fields are written, zero values included. If fields are empty, non-zero values are written.
+// If expectedVersion is set, returns VersionMismatch error if experience has another version.
+// Returns TypeNotFound error if the written type is missing in the type catalog
+func (r *MemoryRepo) Update(ctx context.Context, experience models.Experience, fields []string, expectedVersion uint64) error {
+	return r.write(func(s *memoryState) error {
+		return s.change(ctx, models.UpdateAction, updateChange(experience, fields), experience.Id, expectedVersion)
+	})
+}
+
+// RemoveExperiences marks experiences as deleted in a transaction, experience Version is an expected version.
+// Returns NotFound or VersionMismatch error per experience that is not removed
+func (r *MemoryRepo) RemoveExperiences(ctx context.Context, experiences []models.Experience) ([]error, error) {
+	return r.changeExperiences(ctx, models.RemoveAction, experiences, func(int) func(*models.Experience) {
+		return removeChange
+	})
+}
+
+// UpdateExperiences updates experiences in a transaction the same way as Update does with fields of the same index,
+// experience Version is an expected version. Returns NotFound or VersionMismatch error per experience that is not updated.
+// If an error is returned, nothing is updated, TypeNotFound error is returned if a written type is missing
+func (r *MemoryRepo) UpdateExperiences(ctx context.Context, experiences []models.Experience, fields [][]string) ([]error, error) {
+	return r.changeExperiences(ctx, models.UpdateAction, experiences, func(index int) func(*models.Experience) {
+		var experienceFields []string
+
+		if index < len(fields) {
+			experienceFields = fields[index]
+		}
+
+		return updateChange(experiences[index], experienceFields)
+	})
+}
+
+// changeExperiences applies change of every experience in a single transaction,
+// NotFound and VersionMismatch errors are collected per experience
+func (r *MemoryRepo) changeExperiences(ctx context.Context, action models.HistoryAction, experiences []models.Experience, change func(index int) func(*models.Experience)) ([]error, error) {
+	results := make([]error, len(experiences))
+
+	err := r.write(func(s *memoryState) error {
+		for index, experience := range experiences {
+			err := s.change(ctx, action, change(index), experience.Id, experience.Version)
+
+			if errors.Is(err, NotFound) || errors.Is(err, VersionMismatch) {
+				results[index] = err
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ListHistory returns changes of experience by id, the oldest change first
+func (r *MemoryRepo) ListHistory(_ context.Context, experienceId, limit, offset uint64) ([]models.ExperienceHistory, error) {
+	var records []models.ExperienceHistory
+
+	err := r.read(func(s *memoryState) error {
+		for _, record := range s.history {
+			if record.ExperienceId == experienceId {
+				records = append(records, record)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := pageBounds(len(records), limit, offset)
+	return records[start:end], nil
+}
+
+// Overlapping returns experiences of the same user and type with periods overlapping experience period,
+// sorted by id. The experience itself is skipped
+func (r *MemoryRepo) Overlapping(_ context.Context, experience models.Experience) ([]models.Experience, error) {
+	var experiences []models.Experience
+
+	err := r.read(func(s *memoryState) error {
+		for _, other := range s.experiences {
+			if other.Id != experience.Id && other.UserId == experience.UserId && other.Type == experience.Type &&
+				other.DeletedAt.IsZero() && experience.Overlaps(other) {
+				experiences = append(experiences, other)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sortById(experiences)
+	return experiences, nil
+}
+
+// FindOverlaps returns pairs of experiences of the same user and type with overlapping periods sorted by ids.
+// Experiences of all users are checked if userId is zero
+func (r *MemoryRepo) FindOverlaps(_ context.Context, userId, limit, offset uint64) ([]models.Overlap, error) {
+	var experiences []models.Experience
+
+	err := r.read(func(s *memoryState) error {
+		for _, experience := range s.experiences {
+			if experience.DeletedAt.IsZero() && (userId == 0 || experience.UserId == userId) {
+				experiences = append(experiences, experience)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sortById(experiences)
+	var overlaps []models.Overlap
+
+	for i, first := range experiences {
+		for _, second := range experiences[i+1:] {
+			if first.UserId == second.UserId && first.Type == second.Type && first.Overlaps(second) {
+				overlaps = append(overlaps, models.Overlap{
+					UserId:   first.UserId,
+					Type:     first.Type,
+					FirstId:  first.Id,
+					SecondId: second.Id,
+				})
+			}
+		}
+	}
+
+	start, end := pageBounds(len(overlaps), limit, offset)
+	return overlaps[start:end], nil
+}
+
+// UserExperiences returns experiences of a user sorted by id
+func (r *MemoryRepo) UserExperiences(_ context.Context, userId uint64) ([]models.Experience, error) {
+	var experiences []models.Experience
+
+	err := r.read(func(s *memoryState) error {
+		for _, experience := range s.experiences {
+			if experience.UserId == userId && experience.DeletedAt.IsZero() {
+				experiences = append(experiences, experience)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sortById(experiences)
+	return experiences, nil
+}
+
+// AddType adds experience type to the type catalog and returns its id.
+// Returns TypeExists error if the name is taken
+func (r *MemoryRepo) AddType(_ context.Context, experienceType models.ExperienceType) (uint64, error) {
+	var id uint64 = 0
+
+	err := r.write(func(s *memoryState) error {
+		if s.typeNamed(experienceType.Name, 0) {
+			return TypeExists
+		}
+
+		s.lastTypeId++
+		id = s.lastTypeId
+		s.setType(models.ExperienceType{Id: id, Name: experienceType.Name})
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListTypes returns experience types sorted by id
+func (r *MemoryRepo) ListTypes(_ context.Context, limit, offset uint64) ([]models.ExperienceType, error) {
+	var types []models.ExperienceType
+
+	err := r.read(func(s *memoryState) error {
+		for _, experienceType := range s.types {
+			types = append(types, experienceType)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Id < types[j].Id
+	})
+
+	start, end := pageBounds(len(types), limit, offset)
+	return types[start:end], nil
+}
+
+// DescribeType returns experience type by id, returns TypeNotFound error if there is none
+func (r *MemoryRepo) DescribeType(_ context.Context, id uint64) (models.ExperienceType, error) {
+	var experienceType models.ExperienceType
+
+	err := r.read(func(s *memoryState) error {
+		var ok bool
+
+		if experienceType, ok = s.types[id]; !ok {
+			return TypeNotFound
+		}
+
+		return nil
+	})
+
+	return experienceType, err
+}
+
+// UpdateType renames experience type. Returns TypeNotFound error if there is no type,
+// TypeExists error if the name is taken by another type
+func (r *MemoryRepo) UpdateType(_ context.Context, experienceType models.ExperienceType) error {
+	return r.write(func(s *memoryState) error {
+		if s.typeNamed(experienceType.Name, experienceType.Id) {
+			return TypeExists
+		}
+
+		if _, ok := s.types[experienceType.Id]; !ok {
+			return TypeNotFound
+		}
+
+		s.setType(experienceType)
+		return nil
+	})
+}
+
+// RemoveType removes experience type with its level ladder by id. Returns TypeInUse error if experiences
+// including removed ones have the type
+func (r *MemoryRepo) RemoveType(_ context.Context, id uint64) (bool, error) {
+	removed := false
+
+	err := r.write(func(s *memoryState) error {
+		for _, experience := range s.experiences {
+			if experience.Type == id {
+				return TypeInUse
+			}
+		}
+
+		_, removed = s.types[id]
+		s.deleteType(id)
+
+		return nil
+	})
+
+	return removed, err
+}
+
+// TypeNames returns names of experience types by id, types missing in the catalog are not returned
+func (r *MemoryRepo) TypeNames(_ context.Context, ids []uint64) (map[uint64]string, error) {
+	names := make(map[uint64]string, len(ids))
+
+	err := r.read(func(s *memoryState) error {
+		for _, id := range ids {
+			if experienceType, ok := s.types[id]; ok {
+				names[id] = experienceType.Name
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// Ladders returns level ladders of experience types sorted by level, types without ladders are not returned
+func (r *MemoryRepo) Ladders(_ context.Context, typeIds []uint64) (map[uint64]models.Ladder, error) {
+	ladders := make(map[uint64]models.Ladder, len(typeIds))
+
+	err := r.read(func(s *memoryState) error {
+		for _, id := range typeIds {
+			if ladder, ok := s.ladders[id]; ok {
+				ladders[id] = append(models.Ladder(nil), ladder...)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ladders, nil
+}
+
+// SetLadder replaces the level ladder of experience type. Returns TypeNotFound error if there is no type
+func (r *MemoryRepo) SetLadder(_ context.Context, typeId uint64, ladder models.Ladder) error {
+	return r.write(func(s *memoryState) error {
+		if _, ok := s.types[typeId]; !ok {
+			return TypeNotFound
+		}
+
+		stored := make(models.Ladder, 0, len(ladder))
+
+		for _, level := range ladder {
+			level.TypeId = typeId
+			stored = append(stored, level)
+		}
+
+		sort.Slice(stored, func(i, j int) bool {
+			return stored[i].Level < stored[j].Level
+		})
+
+		s.setLadder(typeId, stored)
+		return nil
+	})
+}
+
+// ReserveIdempotencyKey stores idempotency record without response, record ExpiresAt is a lease of the request
+// in progress held by record Lease token. Returns false if the key of the method is already stored and has not expired,
+// an expired record or an in progress record with an expired lease is replaced
+func (r *MemoryRepo) ReserveIdempotencyKey(_ context.Context, record models.IdempotencyRecord) (bool, error) {
+	reserved := false
+
+	err := r.write(func(s *memoryState) error {
+		key := idempotencyKey{method: record.Method, key: record.Key}
+
+		if stored, ok := s.keys[key]; ok && stored.ExpiresAt.After(time.Now()) {
+			return nil
+		}
+
+		record.Response = nil
+		s.setKey(key, record)
+		reserved = true
+
+		return nil
+	})
+
+	return reserved, err
+}
+
+// IdempotencyKey returns idempotency record of the method by key, returns IdempotencyKeyNotFound error
+// if there is none or it is expired
+func (r *MemoryRepo) IdempotencyKey(_ context.Context, method, key string) (models.IdempotencyRecord, error) {
+	var record models.IdempotencyRecord
+
+	err := r.read(func(s *memoryState) error {
+		var ok bool
+		record, ok = s.keys[idempotencyKey{method: method, key: key}]
+
+		if !ok || !record.ExpiresAt.After(time.Now()) {
+			return IdempotencyKeyNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+
+	return record, nil
+}
+
+// CompleteIdempotencyKey stores record Response until record ExpiresAt if the key is still reserved by record Lease
+// and the lease has not expired. Returns IdempotencyLeaseLost error otherwise
+func (r *MemoryRepo) CompleteIdempotencyKey(_ context.Context, record models.IdempotencyRecord) error {
+	return r.write(func(s *memoryState) error {
+		id := idempotencyKey{method: record.Method, key: record.Key}
+		stored, ok := s.keys[id]
+
+		if !ok || !reservedBy(stored, record.Lease) || !stored.ExpiresAt.After(time.Now()) {
+			return IdempotencyLeaseLost
+		}
+
+		stored.Response = record.Response
+		stored.ExpiresAt = record.ExpiresAt
+		s.setKey(id, stored)
+
+		return nil
+	})
+}
+
+// ReleaseIdempotencyKey deletes idempotency record without response reserved by record Lease,
+// so the request may be retried with the key. A key reserved by another request is kept
+func (r *MemoryRepo) ReleaseIdempotencyKey(_ context.Context, record models.IdempotencyRecord) error {
+	return r.write(func(s *memoryState) error {
+		id := idempotencyKey{method: record.Method, key: record.Key}
+
+		if stored, ok := s.keys[id]; ok && reservedBy(stored, record.Lease) {
+			s.deleteKey(id)
+		}
+
+		return nil
+	})
+}
+
+// reservedBy checks that idempotency record is in progress and reserved by lease
+func reservedBy(record models.IdempotencyRecord, lease string) bool {
+	return record.Response == nil && record.Lease == lease
+}
+
+// PurgeIdempotencyKeys deletes idempotency records expired before time, returns number of deleted records
+func (r *MemoryRepo) PurgeIdempotencyKeys(_ context.Context, expiredBefore time.Time) (uint64, error) {
+	var purged uint64 = 0
+
+	err := r.write(func(s *memoryState) error {
+		for key, record := range s.keys {
+			if !record.ExpiresAt.After(expiredBefore) {
+				s.deleteKey(key)
+				purged++
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// change applies change to experience by id, bumps experience version and records the change to history.
+// Only removed experiences are restored, other actions change not removed experiences.
+// Returns NotFound or VersionMismatch error if nothing is changed, TypeNotFound error if the written type
+// is missing in the type catalog
+func (s *memoryState) change(ctx context.Context, action models.HistoryAction, change func(*models.Experience), id, expectedVersion uint64) error {
+	before, ok := s.experiences[id]
+
+	if !ok || before.DeletedAt.IsZero() == (action == models.RestoreAction) {
+		return NotFound
+	}
+
+	if expectedVersion != 0 && before.Version != expectedVersion {
+		return VersionMismatch
+	}
+
+	after := before
+	change(&after)
+	after.Version++
+
+	if _, ok := s.types[after.Type]; !ok && after.Type != before.Type {
+		return TypeNotFound
+	}
+
+	s.setExperience(after)
+	s.addHistory(models.NewExperienceHistory(action, actor.FromContext(ctx), &before, &after))
+
+	return nil
+}
+
+// addHistory writes experience change record
+func (s *memoryState) addHistory(record models.ExperienceHistory) {
+	s.lastHistoryId++
+	record.Id = s.lastHistoryId
+	s.history = append(s.history, record)
+}
+
+// typeNamed reports whether a type other than exceptId has the name
+func (s *memoryState) typeNamed(name string, exceptId uint64) bool {
+	for id, experienceType := range s.types {
+		if id != exceptId && experienceType.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeChange marks experience as deleted
+func removeChange(experience *models.Experience) {
+	experience.DeletedAt = time.Now().UTC()
+}
+
+// updateChange returns change writing experience fields the same way as Repo.Update does
+func updateChange(experience models.Experience, fields []string) func(*models.Experience) {
+	return func(stored *models.Experience) {
+		written := fields
+
+		if len(written) == 0 {
+			written = nonZeroFields(experience)
+		}
+
+		for _, field := range written {
+			switch field {
+			case models.UserIdField:
+				stored.UserId = experience.UserId
+			case models.TypeField:
+				stored.Type = experience.Type
+			case models.FromField:
+				stored.From = experience.From
+			case models.ToField:
+				stored.To = experience.To
+			case models.LevelField:
+				stored.Level = experience.Level
+			}
+		}
+	}
+}
+
+// nonZeroFields returns names of experience fields having non-zero values
+func nonZeroFields(experience models.Experience) []string {
+	var fields []string
+
+	if experience.UserId != 0 {
+		fields = append(fields, models.UserIdField)
+	}
+
+	if experience.Type != 0 {
+		fields = append(fields, models.TypeField)
+	}
+
+	if !experience.From.IsZero() {
+		fields = append(fields, models.FromField)
+	}
+
+	if !experience.To.IsZero() {
+		fields = append(fields, models.ToField)
+	}
+
+	if experience.Level != 0 {
+		fields = append(fields, models.LevelField)
+	}
+
+	return fields
+}
+
+// matchFilter reports whether experience matches filter the same way as applyFilter conditions do
+func matchFilter(experience models.Experience, filter models.ExperienceFilter) bool {
+	if !filter.IncludeDeleted && !experience.DeletedAt.IsZero() {
+		return false
+	}
+
+	if filter.UserId != 0 && experience.UserId != filter.UserId {
+		return false
+	}
+
+	if len(filter.Types) > 0 && !containsId(filter.Types, experience.Type) {
+		return false
+	}
+
+	if filter.OngoingOnly && !experience.IsOngoing() {
+		return false
+	}
+
+	if filter.MinLevel != 0 && experience.Level < filter.MinLevel {
+		return false
+	}
+
+	if filter.MaxLevel != 0 && experience.Level > filter.MaxLevel {
+		return false
+	}
+
+	if !filter.From.IsZero() && !experience.IsOngoing() && experience.To.Before(filter.From) {
+		return false
+	}
+
+	if !filter.To.IsZero() && experience.From.After(filter.To) {
+		return false
+	}
+
+	return true
+}
+
+// less reports whether experience a is placed before b in order, id is used as a tie-breaker.
+// Ongoing experiences are treated as the latest in to order
+func less(order models.ExperienceOrder, a, b models.Experience) bool {
+	result := compareField(order.Field, a, b)
+
+	if result == 0 {
+		result = compareUint(a.Id, b.Id)
+	}
+
+	if order.Desc {
+		return result > 0
+	}
+
+	return result < 0
+}
+
+// compareField compares experiences by order field, unknown fields are equal
+func compareField(field models.OrderField, a, b models.Experience) int {
+	switch field {
+	case models.OrderByUserId:
+		return compareUint(a.UserId, b.UserId)
+	case models.OrderByType:
+		return compareUint(a.Type, b.Type)
+	case models.OrderByLevel:
+		return compareUint(a.Level, b.Level)
+	case models.OrderByFrom:
+		return compareTime(a.From, b.From)
+	case models.OrderByTo:
+		switch {
+		case a.IsOngoing() && b.IsOngoing():
+			return 0
+		case a.IsOngoing():
+			return 1
+		case b.IsOngoing():
+			return -1
+		}
+
+		return compareTime(a.To, b.To)
+	}
+
+	return 0
+}
+
+// cursorExperience returns experience with the sort key and id of cursor
+func cursorExperience(order models.ExperienceOrder, cursor *models.ExperienceCursor) models.Experience {
+	experience := models.Experience{Id: cursor.Id}
+
+	switch order.Field {
+	case models.OrderByUserId:
+		experience.UserId = cursor.Value
+	case models.OrderByType:
+		experience.Type = cursor.Value
+	case models.OrderByLevel:
+		experience.Level = cursor.Value
+	case models.OrderByFrom:
+		experience.From = cursor.Time
+	case models.OrderByTo:
+		experience.To = cursor.Time
+	}
+
+	return experience
+}
+
+// compareUint returns -1, 0 or 1 if a is less than, equal to or greater than b
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// compareTime returns -1, 0 or 1 if a is before, equal to or after b
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+
+	return 0
+}
+
+// containsId reports whether ids contain id
+func containsId(ids []uint64, id uint64) bool {
+	for _, value := range ids {
+		if value == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pageBounds returns bounds of a page of n sorted items
+func pageBounds(n int, limit, offset uint64) (int, int) {
+	start := uint64(n)
+
+	if offset < start {
+		start = offset
+	}
+
+	end := uint64(n)
+
+	if limit < end-start {
+		end = start + limit
+	}
+
+	return int(start), int(end)
+}
+
+// sortById sorts experiences by id
+func sortById(experiences []models.Experience) {
+	sort.Slice(experiences, func(i, j int) bool {
+		return experiences[i].Id < experiences[j].Id
+	})
+}
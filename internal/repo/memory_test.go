@@ -0,0 +1,225 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+var _ = Describe("MemoryRepo", func() {
+	var (
+		rep  *MemoryRepo
+		ctx  context.Context
+		from = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		rep = NewMemoryRepo()
+		ctx = context.Background()
+
+		// experiences have types up to 5
+		for _, name := range []string{"backend", "frontend", "mobile", "devops", "qa"} {
+			_, err := rep.AddType(ctx, models.ExperienceType{Name: name})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("Sequences ids of added experiences", func() {
+		ids, err := rep.AddExperiences(ctx, []models.Experience{
+			models.NewExperience(0, 1, 1, from, to, 1),
+			models.NewExperience(0, 2, 1, from, time.Time{}, 2),
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids).To(Equal([]uint64{1, 2}))
+
+		id, err := rep.Add(ctx, models.NewExperience(0, 3, 1, from, to, 1))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id).To(Equal(uint64(3)))
+
+		experience, err := rep.Describe(ctx, 2)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(experience).To(Equal(models.Experience{Id: 2, UserId: 2, Type: 1, From: from, Level: 2, Version: 1}))
+	})
+
+	It("Returns NotFound for missing and removed experiences", func() {
+		_, err := rep.Describe(ctx, 1)
+		Expect(err).To(Equal(NotFound))
+
+		id, _ := rep.Add(ctx, models.NewExperience(0, 1, 1, from, to, 1))
+
+		removed, err := rep.Remove(ctx, id, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(removed).To(BeTrue())
+
+		_, err = rep.Describe(ctx, id)
+		Expect(err).To(Equal(NotFound))
+
+		removed, err = rep.Remove(ctx, id, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(removed).To(BeFalse())
+
+		Expect(rep.Update(ctx, models.Experience{Id: id, Level: 2}, nil, 0)).To(Equal(NotFound))
+
+		restored, err := rep.Restore(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restored).To(BeTrue())
+
+		experience, err := rep.Describe(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(experience.Version).To(Equal(uint64(3)))
+	})
+
+	It("Writes non-zero values or listed fields on update", func() {
+		id, _ := rep.Add(ctx, models.NewExperience(0, 1, 1, from, to, 1))
+
+		Expect(rep.Update(ctx, models.Experience{Id: id, Level: 3}, nil, 0)).To(Succeed())
+
+		experience, _ := rep.Describe(ctx, id)
+		Expect(experience).To(Equal(models.Experience{Id: id, UserId: 1, Type: 1, From: from, To: to, Level: 3, Version: 2}))
+
+		Expect(rep.Update(ctx, models.Experience{Id: id}, []string{models.ToField}, 2)).To(Succeed())
+
+		experience, _ = rep.Describe(ctx, id)
+		Expect(experience.IsOngoing()).To(BeTrue())
+		Expect(experience.Level).To(Equal(uint64(3)))
+
+		Expect(rep.Update(ctx, models.Experience{Id: id, Level: 4}, nil, 2)).To(Equal(VersionMismatch))
+	})
+
+	It("Records history of changes", func() {
+		id, _ := rep.Add(ctx, models.NewExperience(0, 1, 1, from, to, 1))
+		Expect(rep.Update(ctx, models.Experience{Id: id, Level: 2}, nil, 0)).To(Succeed())
+
+		records, err := rep.ListHistory(ctx, id, 10, 0)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Action).To(Equal(models.CreateAction))
+		Expect(records[1].Action).To(Equal(models.UpdateAction))
+		Expect(records[1].Before.Level).To(Equal(uint64(1)))
+		Expect(records[1].After.Level).To(Equal(uint64(2)))
+	})
+
+	It("Lists experiences sorted by to with ongoing ones last and after cursor", func() {
+		_, _ = rep.AddExperiences(ctx, []models.Experience{
+			models.NewExperience(0, 1, 1, from, time.Time{}, 1),
+			models.NewExperience(0, 1, 1, from, to, 1),
+			models.NewExperience(0, 1, 1, from, from.Add(time.Hour), 1),
+		})
+
+		order := models.ExperienceOrder{Field: models.OrderByTo}
+		experiences, err := rep.List(ctx, models.ExperienceFilter{}, order, nil, 2, 0)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(experiences).To(HaveLen(2))
+		Expect(experiences[0].Id).To(Equal(uint64(3)))
+		Expect(experiences[1].Id).To(Equal(uint64(2)))
+
+		cursor := models.NewCursor(order, experiences[1])
+		experiences, err = rep.List(ctx, models.ExperienceFilter{}, order, &cursor, 2, 0)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(experiences).To(HaveLen(1))
+		Expect(experiences[0].Id).To(Equal(uint64(1)))
+	})
+
+	It("Rolls back transaction on error", func() {
+		expectedError := errors.New("test error")
+
+		err := rep.RunInTx(ctx, func(tx IRepo) error {
+			if _, err := tx.Add(ctx, models.NewExperience(0, 1, 1, from, to, 1)); err != nil {
+				return err
+			}
+
+			return expectedError
+		})
+
+		Expect(err).To(Equal(expectedError))
+
+		count, err := rep.Count(ctx, models.ExperienceFilter{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(uint64(0)))
+	})
+
+	It("Reverts changes of rolled back transaction", func() {
+		id, _ := rep.Add(ctx, models.NewExperience(0, 1, 5, from, to, 1))
+		typeId, _ := rep.AddType(ctx, models.ExperienceType{Name: "data"})
+		ladder := models.Ladder{{TypeId: typeId, Level: 1, Name: "junior"}}
+		Expect(rep.SetLadder(ctx, typeId, ladder)).To(Succeed())
+
+		err := rep.RunInTx(ctx, func(tx IRepo) error {
+			Expect(tx.Update(ctx, models.Experience{Id: id, Level: 2}, nil, 0)).To(Succeed())
+			_, err := tx.Add(ctx, models.NewExperience(0, 2, 5, from, to, 1))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tx.RemoveType(ctx, typeId)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tx.ReserveIdempotencyKey(ctx, models.IdempotencyRecord{Method: "method", Key: "key", ExpiresAt: to.AddDate(100, 0, 0)})
+			Expect(err).ToNot(HaveOccurred())
+
+			return errors.New("test error")
+		})
+
+		Expect(err).To(HaveOccurred())
+
+		experience, _ := rep.Describe(ctx, id)
+		Expect(experience.Level).To(Equal(uint64(1)))
+		Expect(experience.Version).To(Equal(uint64(1)))
+
+		records, _ := rep.ListHistory(ctx, id, 10, 0)
+		Expect(records).To(HaveLen(1))
+
+		_, err = rep.DescribeType(ctx, typeId)
+		Expect(err).ToNot(HaveOccurred())
+
+		ladders, _ := rep.Ladders(ctx, []uint64{typeId})
+		Expect(ladders[typeId]).To(Equal(ladder))
+
+		_, err = rep.IdempotencyKey(ctx, "method", "key")
+		Expect(err).To(Equal(IdempotencyKeyNotFound))
+
+		nextId, _ := rep.Add(ctx, models.NewExperience(0, 2, 5, from, to, 1))
+		Expect(nextId).To(Equal(id + 1))
+	})
+
+	It("Adds experiences concurrently", func() {
+		var wg sync.WaitGroup
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				_, _ = rep.Add(ctx, models.NewExperience(0, 1, 1, from, to, 1))
+			}()
+		}
+
+		wg.Wait()
+
+		count, err := rep.Count(ctx, models.ExperienceFilter{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(uint64(10)))
+	})
+
+	It("Rejects removing type used by experiences", func() {
+		typeId, err := rep.AddType(ctx, models.ExperienceType{Name: "data"})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = rep.AddType(ctx, models.ExperienceType{Name: "data"})
+		Expect(err).To(Equal(TypeExists))
+
+		_, _ = rep.Add(ctx, models.NewExperience(0, 1, typeId, from, to, 1))
+
+		_, err = rep.RemoveType(ctx, typeId)
+		Expect(err).To(Equal(TypeInUse))
+	})
+})
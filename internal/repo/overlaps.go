@@ -0,0 +1,151 @@
+package repo
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+// userLockClass is the advisory lock key space of per user locks
+const userLockClass = 1
+
+// Overlapping returns experiences of the same user and type with periods overlapping experience period,
+// sorted by id. The experience itself is skipped, found experiences are locked in a transaction.
+// The transaction also locks the user, so concurrent transactions can not add overlapping experiences
+func (r *Repo) Overlapping(ctx context.Context, experience models.Experience) ([]models.Experience, error) {
+	if err := r.lockUser(ctx, experience.UserId); err != nil {
+		return nil, err
+	}
+
+	query := r.builder.Select(experienceColumns).
+		From("experiences").
+		Where("user_id = ?", experience.UserId).
+		Where("type = ?", experience.Type).
+		Where("id <> ?", experience.Id).
+		Where("deleted_at IS NULL").
+		Where("("+toColumn+" IS NULL OR "+toColumn+" > ?)", experience.From)
+
+	if !experience.IsOngoing() {
+		query = query.Where(fromColumn+" < ?", experience.To)
+	}
+
+	rows, err := r.forUpdate(query.OrderBy("id ASC")).QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var experiences []models.Experience
+
+	for rows.Next() {
+		experience, err := scanExperience(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		experiences = append(experiences, experience)
+	}
+
+	return experiences, rows.Err()
+}
+
+// FindOverlaps returns pairs of experiences of the same user and type with overlapping periods sorted by ids.
+// Experiences of all users are checked if userId is zero
+func (r *Repo) FindOverlaps(ctx context.Context, userId, limit, offset uint64) ([]models.Overlap, error) {
+	query := r.reader(ctx).Select("a.user_id, a.type, a.id, b.id").
+		From("experiences a").
+		Join("experiences b ON a.user_id = b.user_id AND a.type = b.type AND a.id < b.id").
+		Where("a.deleted_at IS NULL").
+		Where("b.deleted_at IS NULL").
+		Where("(a." + toColumn + " IS NULL OR a." + toColumn + " > b." + fromColumn + ")").
+		Where("(b." + toColumn + " IS NULL OR b." + toColumn + " > a." + fromColumn + ")")
+
+	if userId != 0 {
+		query = query.Where("a.user_id = ?", userId)
+	}
+
+	rows, err := query.OrderBy("a.id ASC", "b.id ASC").
+		Offset(offset).
+		Limit(limit).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	overlaps := make([]models.Overlap, 0, limit)
+
+	for rows.Next() {
+		var overlap models.Overlap
+
+		if err := rows.Scan(&overlap.UserId, &overlap.Type, &overlap.FirstId, &overlap.SecondId); err != nil {
+			return nil, err
+		}
+
+		overlaps = append(overlaps, overlap)
+	}
+
+	return overlaps, rows.Err()
+}
+
+// UserExperiences returns experiences of a user sorted by id, the user and experiences are locked in a transaction.
+// Out of a transaction experiences are read like lists
+func (r *Repo) UserExperiences(ctx context.Context, userId uint64) ([]models.Experience, error) {
+	if err := r.lockUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	query := r.reader(ctx).Select(experienceColumns).
+		From("experiences").
+		Where("user_id = ?", userId).
+		Where("deleted_at IS NULL").
+		OrderBy("id ASC")
+
+	if r.tx != nil {
+		query = r.forUpdate(query)
+	}
+
+	rows, err := query.QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var experiences []models.Experience
+
+	for rows.Next() {
+		experience, err := scanExperience(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		experiences = append(experiences, experience)
+	}
+
+	return experiences, rows.Err()
+}
+
+// lockUser takes transaction advisory lock of user if repo is bound to a transaction and storage supports locks.
+// Row locks do not cover experiences inserted by concurrent transactions, so checks of user experiences
+// are serialized by the user lock. User ids are truncated to lock keys, colliding users share a lock
+func (r *Repo) lockUser(ctx context.Context, userId uint64) error {
+	if r.tx == nil || !r.lockRows {
+		return nil
+	}
+
+	_, err := r.builder.Select().
+		Column(sq.Expr("pg_advisory_xact_lock(?, ?)", int32(userLockClass), int32(userId))).
+		ExecContext(ctx)
+
+	return err
+}
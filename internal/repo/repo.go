@@ -2,98 +2,232 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
-	sql "github.com/jmoiron/sqlx"
-
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/ozoncp/ocp-experience-api/internal/actor"
+	"github.com/ozoncp/ocp-experience-api/internal/consistency"
 	"github.com/ozoncp/ocp-experience-api/internal/models"
 )
 
 var NotFound = errors.New("experience does not exist")
+var VersionMismatch = errors.New("experience version does not match")
+
+// foreignKeyViolation is PostgreSQL SQLSTATE of foreign key violations
+const foreignKeyViolation = "23503"
+
+// quoted experience columns named after reserved words
+const (
+	fromColumn = `"from"`
+	toColumn   = `"to"`
+)
+
+// to column order, ongoing experiences are treated as the latest
+var toOrder = map[bool]string{
+	false: toColumn + " ASC NULLS LAST",
+	true:  toColumn + " DESC NULLS FIRST",
+}
+
+// experience columns to select
+const experienceColumns = "id, user_id, type, " + fromColumn + ", " + toColumn + ", level, version, deleted_at"
+
+// columns to sort experience list by
+var orderColumns = map[models.OrderField]string{
+	models.OrderById:     "id",
+	models.OrderByUserId: "user_id",
+	models.OrderByType:   "type",
+	models.OrderByFrom:   fromColumn,
+	models.OrderByTo:     toColumn,
+	models.OrderByLevel:  "level",
+}
 
 // IRepo is an experience storage interface
 type IRepo interface {
 	Add(ctx context.Context, request models.Experience) (uint64, error)
 	AddExperiences(ctx context.Context, request []models.Experience) ([]uint64, error)
-	List(ctx context.Context, limit, offset uint64) ([]models.Experience, error)
+	List(ctx context.Context, filter models.ExperienceFilter, order models.ExperienceOrder, after *models.ExperienceCursor, limit, offset uint64) ([]models.Experience, error)
+	Count(ctx context.Context, filter models.ExperienceFilter) (uint64, error)
 	Describe(ctx context.Context, id uint64) (models.Experience, error)
-	Remove(ctx context.Context, id uint64) (bool, error)
-	Update(ctx context.Context, experience models.Experience) error
+	Remove(ctx context.Context, id, expectedVersion uint64) (bool, error)
+	Restore(ctx context.Context, id uint64) (bool, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (uint64, error)
+	Update(ctx context.Context, experience models.Experience, fields []string, expectedVersion uint64) error
+	RemoveExperiences(ctx context.Context, experiences []models.Experience) ([]error, error)
+	UpdateExperiences(ctx context.Context, experiences []models.Experience, fields [][]string) ([]error, error)
+	ListHistory(ctx context.Context, experienceId, limit, offset uint64) ([]models.ExperienceHistory, error)
+	Overlapping(ctx context.Context, experience models.Experience) ([]models.Experience, error)
+	FindOverlaps(ctx context.Context, userId, limit, offset uint64) ([]models.Overlap, error)
+	UserExperiences(ctx context.Context, userId uint64) ([]models.Experience, error)
+	RunInTx(ctx context.Context, fn func(tx IRepo) error) error
+
+	AddType(ctx context.Context, experienceType models.ExperienceType) (uint64, error)
+	ListTypes(ctx context.Context, limit, offset uint64) ([]models.ExperienceType, error)
+	DescribeType(ctx context.Context, id uint64) (models.ExperienceType, error)
+	UpdateType(ctx context.Context, experienceType models.ExperienceType) error
+	RemoveType(ctx context.Context, id uint64) (bool, error)
+	TypeNames(ctx context.Context, ids []uint64) (map[uint64]string, error)
+	Ladders(ctx context.Context, typeIds []uint64) (map[uint64]models.Ladder, error)
+	SetLadder(ctx context.Context, typeId uint64, ladder models.Ladder) error
+
+	ReserveIdempotencyKey(ctx context.Context, record models.IdempotencyRecord) (bool, error)
+	IdempotencyKey(ctx context.Context, method, key string) (models.IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, record models.IdempotencyRecord) error
+	ReleaseIdempotencyKey(ctx context.Context, record models.IdempotencyRecord) error
+	PurgeIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (uint64, error)
 }
 
 // NewRepo creates a new Repo
-func NewRepo(db *sql.DB) *Repo {
-	cache := sq.NewStmtCache(db)
+func NewRepo(db *sqlx.DB) *Repo {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(sq.NewStmtCache(db))
 
 	return &Repo{
-		builder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(cache),
+		db:       db,
+		builder:  builder,
+		replica:  builder,
+		lockRows: true,
 	}
 }
 
+// NewReplicatedRepo creates a Repo writing to primary and reading lists and descriptions from replica.
+// Reads of a context requesting consistency.ReadYourWrites go to primary
+func NewReplicatedRepo(primary, replica *sqlx.DB) *Repo {
+	rep := NewRepo(primary)
+	rep.replica = sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(sq.NewStmtCache(replica))
+
+	return rep
+}
+
 // Repo is IRepo impl
 type Repo struct {
-	builder sq.StatementBuilderType
+	db       *sqlx.DB
+	tx       sq.BaseRunner // set if repo is bound to a transaction
+	builder  sq.StatementBuilderType
+	replica  sq.StatementBuilderType // reads lagging behind writes, equals builder if there is no replica
+	lockRows bool                    // rows read in a transaction are locked with FOR UPDATE
 }
 
-// Add adds to db experience and returns its id
-func (r *Repo) Add(ctx context.Context, experience models.Experience) (uint64, error) {
-	query := r.builder.Insert("experiences").
-		Columns("user_id", "type", "from", "to", "level").
-		Suffix("RETURNING id").
-		Values(experience.UserId, experience.Type, experience.From, experience.To, experience.Level)
+// reader returns replica builder unless ctx requests to read own writes
+func (r *Repo) reader(ctx context.Context) sq.StatementBuilderType {
+	if consistency.ReadYourWrites(ctx) {
+		return r.builder
+	}
 
-	var id uint64 = 0
-	rows, err := query.QueryContext(ctx)
+	return r.replica
+}
 
-	if err != nil {
-		return id, err
-	}
+// RunInTx runs fn in a transaction, fn should use tx repo to take part in the transaction.
+// The transaction is rolled back if fn returns an error. If repo is already bound to a transaction, fn joins it
+func (r *Repo) RunInTx(ctx context.Context, fn func(tx IRepo) error) error {
+	return r.inTx(ctx, func(tx sq.BaseRunner) error {
+		builder := r.builder.RunWith(tx)
+
+		return fn(&Repo{
+			db:       r.db,
+			tx:       tx,
+			builder:  builder,
+			replica:  builder,
+			lockRows: r.lockRows,
+		})
+	})
+}
 
-	rows.Next()
-	scanErr := rows.Scan(&id)
+// Add adds to db experience and returns its id
+func (r *Repo) Add(ctx context.Context, experience models.Experience) (uint64, error) {
+	ids, err := r.AddExperiences(ctx, []models.Experience{experience})
 
-	if scanErr != nil {
-		return id, scanErr
+	if err != nil {
+		return 0, err
 	}
 
-	return id, nil
+	return ids[0], nil
 }
 
-// AddExperiences adds to db experience slice
+// AddExperiences adds to db experience slice. Returns TypeNotFound error if a type is missing in the type catalog
 func (r *Repo) AddExperiences(ctx context.Context, experiences []models.Experience) ([]uint64, error) {
-	query := r.builder.Insert("experiences").Columns("user_id", "type", "from", "to", "level").Suffix("RETURNING id")
+	newIds := make([]uint64, 0, len(experiences))
 
-	for _, experience := range experiences {
-		query = query.Values(experience.UserId, experience.Type, experience.From, experience.To, experience.Level)
-	}
+	err := r.inTx(ctx, func(tx sq.BaseRunner) error {
+		builder := r.builder.RunWith(tx)
+		query := builder.Insert("experiences").Columns("user_id", "type", fromColumn, toColumn, "level").Suffix("RETURNING id")
 
-	rows, err := query.QueryContext(ctx)
+		for _, experience := range experiences {
+			query = query.Values(experience.UserId, experience.Type, experience.From, nullTime(experience.To), experience.Level)
+		}
 
-	if err != nil {
-		return nil, err
-	}
+		rows, err := query.QueryContext(ctx)
 
-	newIds := make([]uint64, 0, len(experiences))
+		if err != nil {
+			return err
+		}
 
-	for rows.Next() {
-		var id uint64 = 0
-		scanErr := rows.Scan(&id)
+		defer rows.Close()
 
-		if scanErr != nil {
-			return nil, scanErr
+		for rows.Next() {
+			var id uint64 = 0
+			scanErr := rows.Scan(&id)
+
+			if scanErr != nil {
+				return scanErr
+			}
+
+			newIds = append(newIds, id)
 		}
 
-		newIds = append(newIds, id)
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if err := rows.Close(); err != nil {
+			return err
+		}
+
+		records := make([]models.ExperienceHistory, 0, len(newIds))
+		caller := actor.FromContext(ctx)
+
+		for index, id := range newIds {
+			created := experiences[index]
+			created.Id = id
+			created.Version = 1
+
+			records = append(records, models.NewExperienceHistory(models.CreateAction, caller, nil, &created))
+		}
+
+		return addHistory(ctx, builder, records)
+	})
+
+	if isForeignKeyViolation(err) {
+		return nil, TypeNotFound
+	}
+
+	if err != nil {
+		return nil, err
 	}
 
 	return newIds, nil
 }
 
-// List returns an experience list
-func (r *Repo) List(ctx context.Context, limit, offset uint64) ([]models.Experience, error) {
-	query := r.builder.Select("id, user_id, type, from, to, level").
-		From("experiences").
+// List returns an experience list matching filter sorted by order.
+// If after is set, the list starts right after the cursor position
+func (r *Repo) List(ctx context.Context, filter models.ExperienceFilter, order models.ExperienceOrder, after *models.ExperienceCursor, limit, offset uint64) ([]models.Experience, error) {
+	query := r.reader(ctx).Select(experienceColumns).
+		From("experiences")
+
+	query = applyFilter(query, filter)
+
+	if after != nil {
+		query = query.Where(afterCursor(order, after))
+	}
+
+	query = query.OrderBy(orderBy(order)...).
 		Offset(offset).
 		Limit(limit)
 
@@ -103,11 +237,12 @@ func (r *Repo) List(ctx context.Context, limit, offset uint64) ([]models.Experie
 		return nil, err
 	}
 
+	defer rows.Close()
+
 	experiences := make([]models.Experience, 0, limit)
 
 	for rows.Next() {
-		var experience models.Experience
-		scanErr := rows.Scan(&experience.Id, &experience.UserId, &experience.Type, &experience.From, &experience.To, &experience.Level)
+		experience, scanErr := scanExperience(rows)
 
 		if scanErr != nil {
 			return nil, scanErr
@@ -119,11 +254,46 @@ func (r *Repo) List(ctx context.Context, limit, offset uint64) ([]models.Experie
 	return experiences, nil
 }
 
-// Describe returns experience by id
+// Count returns number of experiences matching filter
+func (r *Repo) Count(ctx context.Context, filter models.ExperienceFilter) (uint64, error) {
+	query := applyFilter(r.reader(ctx).Select("COUNT(*)").From("experiences"), filter)
+	rows, err := query.QueryContext(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+
+		return 0, errors.New("count query returned no rows")
+	}
+
+	var count uint64 = 0
+	scanErr := rows.Scan(&count)
+
+	if scanErr != nil {
+		return 0, scanErr
+	}
+
+	return count, nil
+}
+
+// Describe returns experience by id, removed experiences are not returned.
+// The experience is locked in a transaction, so it can be checked before it is changed
 func (r *Repo) Describe(ctx context.Context, id uint64) (models.Experience, error) {
-	query := r.builder.Select("id, user_id, type, from, to, level").
+	query := r.reader(ctx).Select(experienceColumns).
 		From("experiences").
-		Where("id = ?", id)
+		Where("id = ?", id).
+		Where("deleted_at IS NULL")
+
+	if r.tx != nil {
+		query = r.forUpdate(query)
+	}
 
 	row, err := query.QueryContext(ctx)
 
@@ -131,13 +301,13 @@ func (r *Repo) Describe(ctx context.Context, id uint64) (models.Experience, erro
 		return models.Experience{}, err
 	}
 
-	var experience models.Experience
+	defer row.Close()
 
 	if !row.Next() {
 		return models.Experience{}, NotFound
 	}
 
-	scanErr := row.Scan(&experience.Id, &experience.UserId, &experience.Type, &experience.From, &experience.To, &experience.Level)
+	experience, scanErr := scanExperience(row)
 
 	if scanErr != nil {
 		return models.Experience{}, scanErr
@@ -146,28 +316,108 @@ func (r *Repo) Describe(ctx context.Context, id uint64) (models.Experience, erro
 	return experience, nil
 }
 
-// Remove deletes experience by id
-func (r *Repo) Remove(ctx context.Context, id uint64) (bool, error) {
-	query := r.builder.Delete("experiences").Where("id = ?", id)
-	ret, err := query.ExecContext(ctx)
+// Remove marks experience as deleted by id, it can be restored until purged.
+// If expectedVersion is set, returns VersionMismatch error if experience has another version
+func (r *Repo) Remove(ctx context.Context, id, expectedVersion uint64) (bool, error) {
+	err := r.change(ctx, models.RemoveAction, r.removeQuery(), id, expectedVersion)
+
+	if errors.Is(err, NotFound) {
+		return false, nil
+	}
 
 	if err != nil {
 		return false, err
 	}
 
-	rowsDeleted, err := ret.RowsAffected()
+	return true, nil
+}
+
+// Restore unmarks removed experience by id
+func (r *Repo) Restore(ctx context.Context, id uint64) (bool, error) {
+	query := r.builder.Update("experiences").Set("deleted_at", nil)
+	err := r.change(ctx, models.RestoreAction, query, id, 0)
+
+	if errors.Is(err, NotFound) {
+		return false, nil
+	}
 
 	if err != nil {
 		return false, err
 	}
 
-	return rowsDeleted > 0, err
+	return true, nil
+}
+
+// Purge deletes experiences removed before deletedBefore, returns number of deleted experiences
+func (r *Repo) Purge(ctx context.Context, deletedBefore time.Time) (uint64, error) {
+	query := r.builder.Delete("experiences").Where("deleted_at < ?", deletedBefore.UTC())
+	ret, err := query.ExecContext(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	rowsDeleted, err := ret.RowsAffected()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(rowsDeleted), nil
+}
+
+// Update updates existing experience and bumps its version, returns NotFound error if request does not exist.
+// Only fields are written, zero values included. If fields are empty, non-zero values are written.
+// If expectedVersion is set, returns VersionMismatch error if experience has another version.
+// Returns TypeNotFound error if the written type is missing in the type catalog
+func (r *Repo) Update(ctx context.Context, experience models.Experience, fields []string, expectedVersion uint64) error {
+	return r.change(ctx, models.UpdateAction, r.updateQuery(experience, fields), experience.Id, expectedVersion)
+}
+
+// RemoveExperiences marks experiences as deleted in a transaction, experience Version is an expected version.
+// Returns NotFound or VersionMismatch error per experience that is not removed.
+// If an error is returned, nothing is removed
+func (r *Repo) RemoveExperiences(ctx context.Context, experiences []models.Experience) ([]error, error) {
+	return r.changeExperiences(ctx, models.RemoveAction, experiences, func(int) sq.UpdateBuilder {
+		return r.removeQuery()
+	})
 }
 
-// Update updates existing experience, returns NotFound error if request does not exist
-func (r *Repo) Update(ctx context.Context, experience models.Experience) error {
+// UpdateExperiences updates experiences in a transaction the same way as Update does with fields of the same index,
+// experience Version is an expected version. Returns NotFound or VersionMismatch error per experience that is not updated.
+// If an error is returned, nothing is updated, TypeNotFound error is returned if a written type is missing
+func (r *Repo) UpdateExperiences(ctx context.Context, experiences []models.Experience, fields [][]string) ([]error, error) {
+	return r.changeExperiences(ctx, models.UpdateAction, experiences, func(index int) sq.UpdateBuilder {
+		var experienceFields []string
+
+		if index < len(fields) {
+			experienceFields = fields[index]
+		}
+
+		return r.updateQuery(experiences[index], experienceFields)
+	})
+}
+
+// removeQuery returns experience soft delete query
+func (r *Repo) removeQuery() sq.UpdateBuilder {
+	return r.builder.Update("experiences").Set("deleted_at", time.Now().UTC())
+}
+
+// updateQuery returns experience update query. Only fields are written, zero values included.
+// If fields are empty, non-zero values are written
+func (r *Repo) updateQuery(experience models.Experience, fields []string) sq.UpdateBuilder {
 	query := r.builder.Update("experiences")
 
+	if len(fields) > 0 {
+		values := fieldValues(experience)
+
+		for _, field := range fields {
+			query = query.Set(fieldColumn(field), values[field])
+		}
+
+		return query
+	}
+
 	if experience.UserId != 0 {
 		query = query.Set("user_id", experience.UserId)
 	}
@@ -177,33 +427,365 @@ func (r *Repo) Update(ctx context.Context, experience models.Experience) error {
 	}
 
 	if !experience.From.IsZero() {
-		query = query.Set("from", experience.From)
+		query = query.Set(fromColumn, experience.From)
 	}
 
 	if !experience.To.IsZero() {
-		query = query.Set("to", experience.To)
+		query = query.Set(toColumn, experience.To)
 	}
 
 	if experience.Level != 0 {
 		query = query.Set("level", experience.Level)
 	}
 
-	query = query.Where("id = ?", experience.Id)
-	ret, err := query.ExecContext(ctx)
+	return query
+}
+
+// fieldColumn returns column name of experience field, reserved words are quoted
+func fieldColumn(field string) string {
+	switch field {
+	case models.FromField:
+		return fromColumn
+	case models.ToField:
+		return toColumn
+	}
+
+	return field
+}
+
+// changeExperiences runs query of every experience in a single transaction.
+// NotFound and VersionMismatch errors are collected per experience, any other error rolls the transaction back
+func (r *Repo) changeExperiences(ctx context.Context, action models.HistoryAction, experiences []models.Experience, query func(index int) sq.UpdateBuilder) ([]error, error) {
+	results := make([]error, len(experiences))
+
+	err := r.inTx(ctx, func(tx sq.BaseRunner) error {
+		for index, experience := range experiences {
+			err := r.changeTx(ctx, tx, action, query(index), experience.Id, experience.Version)
+
+			if errors.Is(err, NotFound) || errors.Is(err, VersionMismatch) {
+				results[index] = err
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// change runs update query for experience id in a transaction, bumps experience version and records the change
+// to history. Only removed experiences are restored, other actions change not removed experiences.
+// Returns NotFound or VersionMismatch error if nothing is changed, TypeNotFound error if the written type
+// is missing in the type catalog
+func (r *Repo) change(ctx context.Context, action models.HistoryAction, query sq.UpdateBuilder, id, expectedVersion uint64) error {
+	return r.inTx(ctx, func(tx sq.BaseRunner) error {
+		return r.changeTx(ctx, tx, action, query, id, expectedVersion)
+	})
+}
+
+// changeTx is change running in transaction tx
+func (r *Repo) changeTx(ctx context.Context, tx sq.BaseRunner, action models.HistoryAction, query sq.UpdateBuilder, id, expectedVersion uint64) error {
+	deleted := "deleted_at IS NULL"
+
+	if action == models.RestoreAction {
+		deleted = "deleted_at IS NOT NULL"
+	}
+
+	builder := r.builder.RunWith(tx)
+	before, err := r.selectForUpdate(ctx, builder, id, deleted)
 
 	if err != nil {
 		return err
 	}
 
-	rowsUpdated, err := ret.RowsAffected()
+	if expectedVersion != 0 && before.Version != expectedVersion {
+		return VersionMismatch
+	}
+
+	rows, err := query.Set("version", sq.Expr("version + 1")).
+		Where("id = ?", id).
+		Suffix("RETURNING " + experienceColumns).
+		RunWith(tx).
+		QueryContext(ctx)
+
+	if isForeignKeyViolation(err) {
+		return TypeNotFound
+	}
 
 	if err != nil {
 		return err
 	}
 
-	if rowsUpdated == 0 {
+	defer rows.Close()
+
+	if !rows.Next() {
+		err := rows.Err()
+
+		if isForeignKeyViolation(err) {
+			return TypeNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
 		return NotFound
 	}
 
-	return nil
+	after, err := scanExperience(rows)
+
+	if err != nil {
+		return err
+	}
+
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	record := models.NewExperienceHistory(action, actor.FromContext(ctx), &before, &after)
+	return addHistory(ctx, builder, []models.ExperienceHistory{record})
+}
+
+// inTx runs fn in a transaction, fn should run its queries with tx.
+// The transaction is rolled back if fn returns an error. If repo is bound to a transaction, fn runs in it
+func (r *Repo) inTx(ctx context.Context, fn func(tx sq.BaseRunner) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx.Tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Error().Err(rollbackErr).Msgf("Failed to rollback transaction")
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// selectForUpdate locks and returns experience by id matching condition, returns NotFound error if there is none
+func (r *Repo) selectForUpdate(ctx context.Context, builder sq.StatementBuilderType, id uint64, condition string) (models.Experience, error) {
+	query := builder.Select(experienceColumns).
+		From("experiences").
+		Where("id = ?", id).
+		Where(condition)
+
+	rows, err := r.forUpdate(query).QueryContext(ctx)
+
+	if err != nil {
+		return models.Experience{}, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return models.Experience{}, NotFound
+	}
+
+	return scanExperience(rows)
+}
+
+// forUpdate locks selected rows until the transaction ends if storage supports row locks.
+// Storages without row locks serialize transactions
+func (r *Repo) forUpdate(query sq.SelectBuilder) sq.SelectBuilder {
+	if !r.lockRows {
+		return query
+	}
+
+	return query.Suffix("FOR UPDATE")
+}
+
+// isForeignKeyViolation reports whether err is a PostgreSQL or SQLite foreign key violation
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == foreignKeyViolation
+	}
+
+	var sqliteErr *sqlite.Error
+
+	// violations of queries with RETURNING clause are reported with a generic code
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY ||
+			strings.Contains(sqliteErr.Error(), "FOREIGN KEY constraint failed")
+	}
+
+	return false
+}
+
+// exists reports whether rows are not empty and closes them
+func exists(rows *sql.Rows) (bool, error) {
+	found := rows.Next()
+
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return false, err
+	}
+
+	return found, rows.Close()
+}
+
+// scanner reads a row columns
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanExperience reads experienceColumns of a row
+func scanExperience(row scanner) (models.Experience, error) {
+	var experience models.Experience
+	var to, deletedAt sql.NullTime
+
+	err := row.Scan(&experience.Id, &experience.UserId, &experience.Type, &experience.From, &to, &experience.Level, &experience.Version, &deletedAt)
+
+	if to.Valid {
+		experience.To = to.Time
+	}
+
+	if deletedAt.Valid {
+		experience.DeletedAt = deletedAt.Time
+	}
+
+	return experience, err
+}
+
+// fieldValues maps updatable experience field names to values
+func fieldValues(experience models.Experience) map[string]interface{} {
+	return map[string]interface{}{
+		models.UserIdField: experience.UserId,
+		models.TypeField:   experience.Type,
+		models.FromField:   experience.From,
+		models.ToField:     nullTime(experience.To),
+		models.LevelField:  experience.Level,
+	}
+}
+
+// nullTime converts zero time to NULL
+func nullTime(value time.Time) interface{} {
+	if value.IsZero() {
+		return nil
+	}
+
+	return value
+}
+
+// applyFilter adds filter conditions to query
+func applyFilter(query sq.SelectBuilder, filter models.ExperienceFilter) sq.SelectBuilder {
+	if !filter.IncludeDeleted {
+		query = query.Where("deleted_at IS NULL")
+	}
+
+	if filter.UserId != 0 {
+		query = query.Where(sq.Eq{"user_id": filter.UserId})
+	}
+
+	if len(filter.Types) > 0 {
+		query = query.Where(sq.Eq{"type": filter.Types})
+	}
+
+	if filter.OngoingOnly {
+		query = query.Where(sq.Eq{toColumn: nil})
+	}
+
+	if filter.MinLevel != 0 {
+		query = query.Where(sq.GtOrEq{"level": filter.MinLevel})
+	}
+
+	if filter.MaxLevel != 0 {
+		query = query.Where(sq.LtOrEq{"level": filter.MaxLevel})
+	}
+
+	if !filter.From.IsZero() {
+		query = query.Where(sq.Or{sq.Eq{toColumn: nil}, sq.GtOrEq{toColumn: filter.From}})
+	}
+
+	if !filter.To.IsZero() {
+		query = query.Where(sq.LtOrEq{fromColumn: filter.To})
+	}
+
+	return query
+}
+
+// afterCursor returns keyset condition selecting rows placed after cursor in order
+func afterCursor(order models.ExperienceOrder, cursor *models.ExperienceCursor) sq.Sqlizer {
+	column := orderColumns[order.Field]
+	operator := ">"
+
+	if order.Desc {
+		operator = "<"
+	}
+
+	if column == "" || column == "id" {
+		return sq.Expr("id "+operator+" ?", cursor.Id)
+	}
+
+	if order.Field == models.OrderByTo {
+		return afterToCursor(order, cursor)
+	}
+
+	var value interface{} = cursor.Value
+
+	if order.Field == models.OrderByFrom {
+		value = cursor.Time
+	}
+
+	return sq.Expr("("+column+", id) "+operator+" (?, ?)", value, cursor.Id)
+}
+
+// afterToCursor returns keyset condition of to order. Ongoing experiences have NULL to and are placed
+// after ended ones in ascending order and before them in descending order
+func afterToCursor(order models.ExperienceOrder, cursor *models.ExperienceCursor) sq.Sqlizer {
+	ongoing := cursor.Time.IsZero()
+
+	switch {
+	case !order.Desc && ongoing:
+		return sq.Expr(toColumn+" IS NULL AND id > ?", cursor.Id)
+	case !order.Desc:
+		return sq.Expr("("+toColumn+" IS NULL OR ("+toColumn+", id) > (?, ?))", cursor.Time, cursor.Id)
+	case ongoing:
+		return sq.Expr("("+toColumn+" IS NOT NULL OR id < ?)", cursor.Id)
+	default:
+		return sq.Expr(toColumn+" IS NOT NULL AND ("+toColumn+", id) < (?, ?)", cursor.Time, cursor.Id)
+	}
+}
+
+// orderBy returns ORDER BY clauses for order, id is used as a tie-breaker
+func orderBy(order models.ExperienceOrder) []string {
+	column, ok := orderColumns[order.Field]
+
+	if !ok {
+		column = orderColumns[models.OrderById]
+	}
+
+	direction := "ASC"
+
+	if order.Desc {
+		direction = "DESC"
+	}
+
+	if column == "id" {
+		return []string{"id " + direction}
+	}
+
+	if column == toColumn {
+		return []string{toOrder[order.Desc], "id " + direction}
+	}
+
+	return []string{column + " " + direction, "id " + direction}
 }
@@ -9,16 +9,25 @@ import (
 	"database/sql/driver"
 
 	"github.com/DATA-DOG/go-sqlmock"
-
-	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/ozoncp/ocp-experience-api/internal/actor"
+	"github.com/ozoncp/ocp-experience-api/internal/consistency"
 	"github.com/ozoncp/ocp-experience-api/internal/models"
 )
 
+// experience columns returned by queries
+var experienceRows = []string{"id", "user_id", "type", "from", "to", "level", "version", "deleted_at"}
+
+// SQL of history record insert
+const insertHistorySQL = "INSERT INTO experience_history \\(experience_id,action,actor,before,after,created_at\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5,\\$6\\)"
+
 var _ = Describe("IRepo", func() {
 	var (
 		rep    IRepo
@@ -49,10 +58,7 @@ var _ = Describe("IRepo", func() {
 
 			Expect(err).ToNot(HaveOccurred())
 
-			cache := sq.NewStmtCache(db)
-			rep = &Repo{
-				builder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(cache),
-			}
+			rep = NewRepo(sqlx.NewDb(db, "sqlmock"))
 		})
 
 		It("Add experience. Expect new ID generated.", func() {
@@ -60,19 +66,41 @@ var _ = Describe("IRepo", func() {
 			expectedNewId := uint64(1)
 			returnRows := sqlmock.NewRows([]string{"id"}).AddRow(expectedNewId)
 
-			dbMock.ExpectPrepare(
-				"INSERT INTO experiences \\(user_id,type,from,to,level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"INSERT INTO experiences \\(user_id,type,\"from\",\"to\",level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
 			).
-				ExpectQuery().
-				WithArgs(newExperience.UserId, newExperience.Type, newExperience.From, newExperience.To, newExperience.Level).
+				WithArgs(newExperience.UserId, newExperience.Type, newExperience.From, nil, newExperience.Level).
 				WillReturnRows(returnRows)
 
+			dbMock.ExpectExec(insertHistorySQL).
+				WithArgs(expectedNewId, "create", "", nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			dbMock.ExpectCommit()
+
 			newId, err := rep.Add(ctx, newExperience)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newId).To(Equal(expectedNewId))
 		})
 
+		It("Add experience of type missing in the type catalog", func() {
+			newExperience := models.NewExperience(1, 1, 7, time.Time{}, time.Time{}, 1)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"INSERT INTO experiences \\(user_id,type,\"from\",\"to\",level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
+			).
+				WithArgs(newExperience.UserId, newExperience.Type, newExperience.From, nil, newExperience.Level).
+				WillReturnError(&pgconn.PgError{Code: "23503"})
+			dbMock.ExpectRollback()
+
+			_, err := rep.Add(ctx, newExperience)
+
+			Expect(err).To(Equal(TypeNotFound))
+		})
+
 		It("Add many requests into repository", func() {
 			experiences := []models.Experience{
 				models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1),
@@ -83,21 +111,29 @@ var _ = Describe("IRepo", func() {
 			expectedQueryArgs := make([]driver.Value, 0, len(experiences) * 3)
 
 			for _, req := range experiences {
-				expectedQueryArgs = append(expectedQueryArgs, req.UserId, req.Type, req.From, req.To, req.Level)
+				expectedQueryArgs = append(expectedQueryArgs, req.UserId, req.Type, req.From, nil, req.Level)
 			}
 
 			expectedIds := []uint64{1, 2, 3}
 
-			dbMock.ExpectPrepare(
-				"INSERT INTO experiences \\(user_id,type,from,to,level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\),\\(\\$6,\\$7,\\$8,\\$9,\\$10\\),\\(\\$11,\\$12,\\$13,\\$14,\\$15\\) RETURNING id",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"INSERT INTO experiences \\(user_id,type,\"from\",\"to\",level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\),\\(\\$6,\\$7,\\$8,\\$9,\\$10\\),\\(\\$11,\\$12,\\$13,\\$14,\\$15\\) RETURNING id",
 			).
-				ExpectQuery().
 				WithArgs(expectedQueryArgs...).
 				WillReturnRows(sqlmock.NewRows([]string{"id"}).
 					AddRow(1).
 					AddRow(2).
 					AddRow(3))
 
+			dbMock.ExpectExec(
+				"INSERT INTO experience_history \\(experience_id,action,actor,before,after,created_at\\) VALUES " +
+					"\\(\\$1,\\$2,\\$3,\\$4,\\$5,\\$6\\),\\(\\$7,\\$8,\\$9,\\$10,\\$11,\\$12\\),\\(\\$13,\\$14,\\$15,\\$16,\\$17,\\$18\\)",
+			).
+				WillReturnResult(sqlmock.NewResult(3, 3))
+
+			dbMock.ExpectCommit()
+
 			newIds, err := rep.AddExperiences(ctx, experiences)
 
 			Expect(err).ToNot(HaveOccurred())
@@ -106,13 +142,13 @@ var _ = Describe("IRepo", func() {
 
 		It("Fetch experiences from database", func() {
 			dbRows := [][]driver.Value{
-				{uint64(1), uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1)},
-				{uint64(2), uint64(2), uint64(2), time.Time{}, time.Time{}, uint64(2)},
-				{uint64(3), uint64(3), uint64(3), time.Time{}, time.Time{}, uint64(3)},
+				{uint64(1), uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(1), nil},
+				{uint64(2), uint64(2), uint64(2), time.Time{}, time.Time{}, uint64(2), uint64(1), nil},
+				{uint64(3), uint64(3), uint64(3), time.Time{}, time.Time{}, uint64(3), uint64(2), nil},
 			}
 
 			expectedExperiences := make([]models.Experience, 0, len(dbRows))
-			returnRows := sqlmock.NewRows([]string{"id", "user_id", "type", "from", "to", "level"})
+			returnRows := sqlmock.NewRows([]string{"id", "user_id", "type", "from", "to", "level", "version", "deleted_at"})
 
 			for _, row := range dbRows {
 				expectedExperiences = append(expectedExperiences, models.Experience{
@@ -122,6 +158,7 @@ var _ = Describe("IRepo", func() {
 					From:   row[3].(time.Time),
 					To:		row[4].(time.Time),
 					Level: 	row[5].(uint64),
+					Version: row[6].(uint64),
 				})
 
 				returnRows.AddRow(row...)
@@ -130,45 +167,158 @@ var _ = Describe("IRepo", func() {
 			offset, limit := uint64(100), uint64(1000)
 
 			dbMock.ExpectPrepare(
-				"SELECT id, user_id, type, from, to, level FROM experiences LIMIT 1000 OFFSET 100",
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE deleted_at IS NULL ORDER BY id ASC LIMIT 1000 OFFSET 100",
 			).
 				ExpectQuery().
 				WillReturnRows(returnRows)
 
-			actualExperiences, err := rep.List(ctx, limit, offset)
+			actualExperiences, err := rep.List(ctx, models.ExperienceFilter{}, models.ExperienceOrder{}, nil, limit, offset)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(actualExperiences).To(Equal(expectedExperiences))
 		})
 
+		It("Fetch filtered and sorted experiences from database", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			filter := models.ExperienceFilter{
+				UserId:   1,
+				Types:    []uint64{2, 3},
+				MinLevel: 1,
+				MaxLevel: 4,
+				From:     from,
+				To:       to,
+			}
+
+			order := models.ExperienceOrder{Field: models.OrderByFrom, Desc: true}
+
+			dbMock.ExpectPrepare(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE deleted_at IS NULL AND user_id = \\$1 AND type IN \\(\\$2,\\$3\\) AND level >= \\$4 AND level <= \\$5 AND \\(\"to\" IS NULL OR \"to\" >= \\$6\\) AND \"from\" <= \\$7 " +
+					"ORDER BY \"from\" DESC, id DESC LIMIT 10 OFFSET 0",
+			).
+				ExpectQuery().
+				WithArgs(filter.UserId, filter.Types[0], filter.Types[1], filter.MinLevel, filter.MaxLevel, from, to).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "type", "from", "to", "level", "version", "deleted_at"}))
+
+			actualExperiences, err := rep.List(ctx, filter, order, nil, 10, 0)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actualExperiences).To(BeEmpty())
+		})
+
+		It("Fetch experiences after page cursor", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			order := models.ExperienceOrder{Field: models.OrderByFrom}
+			cursor := models.NewCursor(order, models.NewExperience(5, 1, 1, from, time.Time{}, 1))
+
+			dbMock.ExpectPrepare(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE deleted_at IS NULL AND user_id = \\$1 AND \\(\"from\", id\\) > \\(\\$2, \\$3\\) " +
+					"ORDER BY \"from\" ASC, id ASC LIMIT 10",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1), from, uint64(5)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "type", "from", "to", "level", "version", "deleted_at"}))
+
+			actualExperiences, err := rep.List(ctx, models.ExperienceFilter{UserId: 1}, order, &cursor, 10, 0)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actualExperiences).To(BeEmpty())
+		})
+
+		It("Fetch ongoing experiences sorted by to", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			order := models.ExperienceOrder{Field: models.OrderByTo}
+			cursor := models.NewCursor(order, models.NewExperience(5, 1, 1, from, time.Time{}, 1))
+
+			dbMock.ExpectPrepare(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE deleted_at IS NULL AND \"to\" IS NULL AND \"to\" IS NULL AND id > \\$1 " +
+					"ORDER BY \"to\" ASC NULLS LAST, id ASC LIMIT 10",
+			).
+				ExpectQuery().
+				WithArgs(uint64(5)).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(6), uint64(1), uint64(1), from, nil, uint64(1), uint64(1), nil))
+
+			actualExperiences, err := rep.List(ctx, models.ExperienceFilter{OngoingOnly: true}, order, &cursor, 10, 0)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actualExperiences).To(HaveLen(1))
+			Expect(actualExperiences[0].IsOngoing()).To(BeTrue())
+		})
+
+		It("Count filtered experiences", func() {
+			dbMock.ExpectPrepare(
+				"SELECT COUNT\\(\\*\\) FROM experiences WHERE deleted_at IS NULL AND user_id = \\$1 AND level >= \\$2",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1), uint64(2)).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(uint64(42)))
+
+			count, err := rep.Count(ctx, models.ExperienceFilter{UserId: 1, MinLevel: 2})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(uint64(42)))
+		})
+
+		It("Count fails if count row is not read", func() {
+			dbMock.ExpectPrepare(
+				"SELECT COUNT\\(\\*\\) FROM experiences WHERE deleted_at IS NULL",
+			).
+				ExpectQuery().
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(uint64(42)).RowError(0, errors.New("connection reset")))
+
+			_, err := rep.Count(ctx, models.ExperienceFilter{})
+
+			Expect(err).To(MatchError("connection reset"))
+		})
+
 		It("Remove experience that exists", func() {
 			id := uint64(100)
-			res := sqlmock.NewResult(0, 1)
 
-			dbMock.ExpectPrepare(
-				"DELETE FROM experiences WHERE id = \\$1",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
 			).
-				ExpectExec().
 				WithArgs(id).
-				WillReturnResult(res)
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(id, uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(1), nil))
+
+			dbMock.ExpectQuery(
+				"UPDATE experiences SET deleted_at = \\$1, version = version \\+ 1 WHERE id = \\$2 " +
+					"RETURNING id, user_id, type, \"from\", \"to\", level, version, deleted_at",
+			).
+				WithArgs(sqlmock.AnyArg(), id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(id, uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(2), time.Now()))
+
+			dbMock.ExpectExec(insertHistorySQL).
+				WithArgs(id, "remove", "", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
 
-			found, err := rep.Remove(ctx, id)
+			dbMock.ExpectCommit()
+
+			found, err := rep.Remove(ctx, id, 0)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(Equal(true))
 		})
 
 		It("Remove experience that does not exist", func() {
 			id := uint64(100)
-			res := sqlmock.NewResult(0, 0)
 
-			dbMock.ExpectPrepare(
-				"DELETE FROM experiences WHERE id = \\$1",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
 			).
-				ExpectExec().
 				WithArgs(id).
-				WillReturnResult(res)
+				WillReturnRows(sqlmock.NewRows(experienceRows))
 
-			found, err := rep.Remove(ctx, id)
+			dbMock.ExpectRollback()
+
+			found, err := rep.Remove(ctx, id, 0)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(Equal(false))
@@ -179,11 +329,11 @@ var _ = Describe("IRepo", func() {
 			experience := models.NewExperience(id, 1, 1, time.Time{}, time.Time{}, 1)
 
 			returnRows := sqlmock.
-				NewRows([]string{"id", "user_id", "type", "from", "to", "level"}).
-				AddRow(experience.Id, experience.UserId, experience.Type, experience.From, experience.To, experience.Level)
+				NewRows([]string{"id", "user_id", "type", "from", "to", "level", "version", "deleted_at"}).
+				AddRow(experience.Id, experience.UserId, experience.Type, experience.From, experience.To, experience.Level, experience.Version, nil)
 
 			dbMock.ExpectPrepare(
-				"SELECT id, user_id, type, from, to, level FROM experiences WHERE id = \\$1",
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL",
 			).
 				ExpectQuery().
 				WithArgs(id).
@@ -199,10 +349,10 @@ var _ = Describe("IRepo", func() {
 			id := uint64(1)
 
 			returnRows := sqlmock.
-				NewRows([]string{"id", "user_id", "type", "from", "to", "level"})
+				NewRows([]string{"id", "user_id", "type", "from", "to", "level", "version", "deleted_at"})
 
 			dbMock.ExpectPrepare(
-				"SELECT id, user_id, type, from, to, level FROM experiences WHERE id = \\$1",
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL",
 			).
 				ExpectQuery().
 				WithArgs(id).
@@ -219,12 +369,12 @@ var _ = Describe("IRepo", func() {
 			expectedError := errors.New("test error")
 
 			dbMock.ExpectPrepare(
-				"SELECT id, user_id, type, from, to, level FROM experiences LIMIT 1000 OFFSET 100",
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE deleted_at IS NULL ORDER BY id ASC LIMIT 1000 OFFSET 100",
 			).
 				ExpectQuery().
 				WillReturnError(expectedError)
 
-			_, err := rep.List(ctx, limit, offset)
+			_, err := rep.List(ctx, models.ExperienceFilter{}, models.ExperienceOrder{}, nil, limit, offset)
 			Expect(err).To(Equal(expectedError))
 		})
 
@@ -232,13 +382,15 @@ var _ = Describe("IRepo", func() {
 			experience := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
 			expectedError := errors.New("test error")
 
-			dbMock.ExpectPrepare(
-				"INSERT INTO experiences \\(user_id,type,from,to,level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"INSERT INTO experiences \\(user_id,type,\"from\",\"to\",level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
 			).
-				ExpectQuery().
-				WithArgs(experience.UserId, experience.Type, experience.From, experience.To, experience.Level).
+				WithArgs(experience.UserId, experience.Type, experience.From, nil, experience.Level).
 				WillReturnError(expectedError)
 
+			dbMock.ExpectRollback()
+
 			newId, err := rep.Add(ctx, experience)
 
 			Expect(err).To(Equal(expectedError))
@@ -248,13 +400,16 @@ var _ = Describe("IRepo", func() {
 		It("Pops up error on AddExperiences", func() {
 			newReq := models.NewExperience(1, 1, 1, time.Time{}, time.Time{}, 1)
 			expectedError := errors.New("test error")
-			dbMock.ExpectPrepare(
-				"INSERT INTO experiences \\(user_id,type,from,to,level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"INSERT INTO experiences \\(user_id,type,\"from\",\"to\",level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id",
 			).
-				ExpectQuery().
-				WithArgs(newReq.UserId, newReq.Type, newReq.From, newReq.To, newReq.Level).
+				WithArgs(newReq.UserId, newReq.Type, newReq.From, nil, newReq.Level).
 				WillReturnError(expectedError)
 
+			dbMock.ExpectRollback()
+
 			_, err := rep.AddExperiences(ctx, []models.Experience{newReq})
 			Expect(err).To(Equal(expectedError))
 		})
@@ -263,14 +418,16 @@ var _ = Describe("IRepo", func() {
 			id := uint64(100)
 			expectedError := errors.New("test error")
 
-			dbMock.ExpectPrepare(
-				"DELETE FROM experiences WHERE id = \\$1",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
 			).
-				ExpectExec().
 				WithArgs(id).
 				WillReturnError(expectedError)
 
-			found, err := rep.Remove(ctx, id)
+			dbMock.ExpectRollback()
+
+			found, err := rep.Remove(ctx, id, 0)
 
 			Expect(err).To(Equal(expectedError))
 			Expect(found).To(Equal(false))
@@ -278,32 +435,692 @@ var _ = Describe("IRepo", func() {
 
 		It("Update experience that is exists", func() {
 			experience := models.NewExperience(1, 1, 1, time.Now(), time.Now(), 1)
-			res := sqlmock.NewResult(0, 1)
+			caller := "admin"
 
-			dbMock.ExpectPrepare(
-				"UPDATE experiences SET user_id = \\$1, type = \\$2, from = \\$3, to = \\$4, level = \\$5 WHERE id = \\$6",
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(experience.Id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(experience.Id, uint64(2), uint64(2), time.Time{}, time.Time{}, uint64(2), uint64(1), nil))
+
+			dbMock.ExpectQuery(
+				"UPDATE experiences SET user_id = \\$1, type = \\$2, \"from\" = \\$3, \"to\" = \\$4, level = \\$5, version = version \\+ 1 WHERE id = \\$6 " +
+					"RETURNING id, user_id, type, \"from\", \"to\", level, version, deleted_at",
 			).
-				ExpectExec().
 				WithArgs(experience.UserId, experience.Type, experience.From, experience.To, experience.Level, experience.Id).
-				WillReturnResult(res)
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(experience.Id, experience.UserId, experience.Type, experience.From, experience.To, experience.Level, uint64(2), nil))
+
+			dbMock.ExpectExec(insertHistorySQL).
+				WithArgs(experience.Id, "update", caller, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			dbMock.ExpectCommit()
 
-			err := rep.Update(ctx, experience)
-			Expect(err).ToNot(Equal(NotFound))
+			err := rep.Update(actor.NewContext(ctx, caller), experience, nil, 0)
+			Expect(err).ToNot(HaveOccurred())
 		})
 
 		It("Update experience that is not exists", func() {
 			experience := models.NewExperience(1, 1, 1, time.Now(), time.Now(), 1)
-			res := sqlmock.NewResult(0, 0)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(experience.Id).
+				WillReturnRows(sqlmock.NewRows(experienceRows))
+
+			dbMock.ExpectRollback()
+
+			err := rep.Update(ctx, experience, nil, 0)
+			Expect(err).To(Equal(NotFound))
+		})
+
+		It("Update experience fields with zero values", func() {
+			experience := models.NewExperience(1, 0, 0, time.Time{}, time.Time{}, 0)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(experience.Id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(experience.Id, uint64(1), uint64(1), time.Time{}, time.Now(), uint64(1), uint64(1), nil))
+
+			dbMock.ExpectQuery(
+				"UPDATE experiences SET level = \\$1, \"to\" = \\$2, version = version \\+ 1 WHERE id = \\$3 " +
+					"RETURNING id, user_id, type, \"from\", \"to\", level, version, deleted_at",
+			).
+				WithArgs(experience.Level, nil, experience.Id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(experience.Id, uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(0), uint64(2), nil))
+
+			dbMock.ExpectExec(insertHistorySQL).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			dbMock.ExpectCommit()
+
+			err := rep.Update(ctx, experience, []string{models.LevelField, models.ToField}, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Update experience with stale version", func() {
+			experience := models.NewExperience(1, 1, 1, time.Now(), time.Now(), 1)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(experience.Id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(experience.Id, experience.UserId, experience.Type, experience.From, experience.To, experience.Level, uint64(4), nil))
+
+			dbMock.ExpectRollback()
+
+			err := rep.Update(ctx, experience, nil, 3)
+			Expect(err).To(Equal(VersionMismatch))
+		})
+
+		It("Remove experience with stale version", func() {
+			id := uint64(100)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(id, uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(4), nil))
+
+			dbMock.ExpectRollback()
+
+			found, err := rep.Remove(ctx, id, 3)
+
+			Expect(err).To(Equal(VersionMismatch))
+			Expect(found).To(Equal(false))
+		})
+
+		It("Restore removed experience", func() {
+			id := uint64(100)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NOT NULL FOR UPDATE",
+			).
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(id, uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(2), time.Now()))
+
+			dbMock.ExpectQuery(
+				"UPDATE experiences SET deleted_at = \\$1, version = version \\+ 1 WHERE id = \\$2 " +
+					"RETURNING id, user_id, type, \"from\", \"to\", level, version, deleted_at",
+			).
+				WithArgs(nil, id).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(id, uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(3), nil))
+
+			dbMock.ExpectExec(insertHistorySQL).
+				WithArgs(id, "restore", "", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			dbMock.ExpectCommit()
+
+			restored, err := rep.Restore(ctx, id)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(Equal(true))
+		})
+
+		It("Purge experiences removed before retention period", func() {
+			deletedBefore := time.Now()
 
 			dbMock.ExpectPrepare(
-				"UPDATE experiences SET user_id = \\$1, type = \\$2, from = \\$3, to = \\$4, level = \\$5 WHERE id = \\$6",
+				"DELETE FROM experiences WHERE deleted_at < \\$1",
 			).
 				ExpectExec().
-				WithArgs(experience.UserId, experience.Type, experience.From, experience.To, experience.Level, experience.Id).
-				WillReturnResult(res)
+				WithArgs(deletedBefore.UTC()).
+				WillReturnResult(sqlmock.NewResult(0, 5))
+
+			purged, err := rep.Purge(ctx, deletedBefore)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(purged).To(Equal(uint64(5)))
+		})
+
+		It("Remove experiences in a transaction", func() {
+			experiences := []models.Experience{{Id: 100}, {Id: 101}}
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(uint64(100)).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(100), uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(1), nil))
+
+			dbMock.ExpectQuery(
+				"UPDATE experiences SET deleted_at = \\$1, version = version \\+ 1 WHERE id = \\$2 " +
+					"RETURNING id, user_id, type, \"from\", \"to\", level, version, deleted_at",
+			).
+				WithArgs(sqlmock.AnyArg(), uint64(100)).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(100), uint64(1), uint64(1), time.Time{}, time.Time{}, uint64(1), uint64(2), time.Now()))
+
+			dbMock.ExpectExec(insertHistorySQL).
+				WithArgs(uint64(100), "remove", "", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(uint64(101)).
+				WillReturnRows(sqlmock.NewRows(experienceRows))
+
+			dbMock.ExpectCommit()
+
+			results, err := rep.RemoveExperiences(ctx, experiences)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]error{nil, NotFound}))
+		})
+
+		It("Rollback experiences update on error", func() {
+			experiences := []models.Experience{models.NewExperience(100, 1, 1, time.Time{}, time.Time{}, 1)}
+			expectedError := errors.New("test error")
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE",
+			).
+				WithArgs(uint64(100)).
+				WillReturnError(expectedError)
+
+			dbMock.ExpectRollback()
+
+			results, err := rep.UpdateExperiences(ctx, experiences, [][]string{{models.LevelField}})
+
+			Expect(err).To(Equal(expectedError))
+			Expect(results).To(BeNil())
+		})
+
+		It("Run repo calls in a transaction", func() {
+			first := models.NewExperience(0, 1, 1, time.Time{}, time.Time{}, 1)
+			second := models.NewExperience(0, 2, 2, time.Time{}, time.Time{}, 2)
+			insertSQL := "INSERT INTO experiences \\(user_id,type,\"from\",\"to\",level\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) RETURNING id"
+
+			dbMock.ExpectBegin()
+
+			for id, experience := range []models.Experience{first, second} {
+				dbMock.ExpectQuery(insertSQL).
+					WithArgs(experience.UserId, experience.Type, experience.From, nil, experience.Level).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id + 1))
+
+				dbMock.ExpectExec(insertHistorySQL).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			dbMock.ExpectCommit()
+
+			var ids []uint64
+
+			err := rep.RunInTx(ctx, func(tx IRepo) error {
+				for _, experience := range []models.Experience{first, second} {
+					id, err := tx.Add(ctx, experience)
+
+					if err != nil {
+						return err
+					}
+
+					ids = append(ids, id)
+				}
+
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(Equal([]uint64{1, 2}))
+		})
+
+		It("Rollback transaction on error", func() {
+			expectedError := errors.New("test error")
 
-			err := rep.Update(ctx, experience)
+			dbMock.ExpectBegin()
+			dbMock.ExpectRollback()
+
+			err := rep.RunInTx(ctx, func(tx IRepo) error {
+				return expectedError
+			})
+
+			Expect(err).To(Equal(expectedError))
+		})
+
+		It("List experience history", func() {
+			id := uint64(100)
+			createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			before := models.NewExperience(id, 1, 1, time.Time{}, time.Time{}, 1)
+			after := models.NewExperience(id, 1, 1, time.Time{}, time.Time{}, 2)
+
+			dbMock.ExpectPrepare(
+				"SELECT id, experience_id, action, actor, before, after, created_at FROM experience_history " +
+					"WHERE experience_id = \\$1 ORDER BY id ASC LIMIT 10 OFFSET 0",
+			).
+				ExpectQuery().
+				WithArgs(id).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "experience_id", "action", "actor", "before", "after", "created_at"}).
+					AddRow(uint64(1), id, "create", "", nil, []byte(`{"id":100,"user_id":1,"type":1,"level":1}`), createdAt).
+					AddRow(uint64(2), id, "update", "admin", []byte(`{"id":100,"user_id":1,"type":1,"level":1}`), []byte(`{"id":100,"user_id":1,"type":1,"level":2}`), createdAt))
+
+			records, err := rep.ListHistory(ctx, id, 10, 0)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([]models.ExperienceHistory{
+				{Id: 1, ExperienceId: id, Action: models.CreateAction, After: &before, CreatedAt: createdAt},
+				{Id: 2, ExperienceId: id, Action: models.UpdateAction, Actor: "admin", Before: &before, After: &after, CreatedAt: createdAt},
+			}))
+		})
+
+		It("Add experience type with taken name", func() {
+			dbMock.ExpectPrepare(
+				"INSERT INTO experience_types \\(name\\) VALUES \\(\\$1\\) ON CONFLICT \\(name\\) DO NOTHING RETURNING id",
+			).
+				ExpectQuery().
+				WithArgs("golang").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			_, err := rep.AddType(ctx, models.ExperienceType{Name: "golang"})
+
+			Expect(err).To(Equal(TypeExists))
+		})
+
+		It("Remove experience type used by experiences", func() {
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery("SELECT id FROM experiences WHERE type = \\$1 LIMIT 1").
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint64(10)))
+			dbMock.ExpectRollback()
+
+			removed, err := rep.RemoveType(ctx, 1)
+
+			Expect(err).To(Equal(TypeInUse))
+			Expect(removed).To(BeFalse())
+		})
+
+		It("Remove experience type used by experience added concurrently", func() {
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery("SELECT id FROM experiences WHERE type = \\$1 LIMIT 1").
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			dbMock.ExpectExec("DELETE FROM experience_types WHERE id = \\$1").
+				WithArgs(uint64(1)).
+				WillReturnError(&pgconn.PgError{Code: "23503"})
+			dbMock.ExpectRollback()
+
+			removed, err := rep.RemoveType(ctx, 1)
+
+			Expect(err).To(Equal(TypeInUse))
+			Expect(removed).To(BeFalse())
+		})
+
+		It("Return names of catalog experience types", func() {
+			dbMock.ExpectPrepare("SELECT id, name FROM experience_types WHERE id IN \\(\\$1,\\$2\\)").
+				ExpectQuery().
+				WithArgs(uint64(1), uint64(2)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(uint64(1), "golang"))
+
+			names, err := rep.TypeNames(ctx, []uint64{1, 2})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal(map[uint64]string{1: "golang"}))
+		})
+
+		It("Return level ladders of experience types", func() {
+			dbMock.ExpectPrepare(
+				"SELECT type_id, level, name, min_duration_seconds FROM experience_levels WHERE type_id IN \\(\\$1\\) ORDER BY type_id ASC, level ASC",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"type_id", "level", "name", "min_duration_seconds"}).
+					AddRow(uint64(1), uint64(1), "junior", int64(0)).
+					AddRow(uint64(1), uint64(2), "middle", int64(3600)))
+
+			ladders, err := rep.Ladders(ctx, []uint64{1})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ladders).To(Equal(map[uint64]models.Ladder{
+				1: {
+					{TypeId: 1, Level: 1, Name: "junior"},
+					{TypeId: 1, Level: 2, Name: "middle", MinDuration: time.Hour},
+				},
+			}))
+		})
+
+		It("Replace level ladder of experience type", func() {
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery("SELECT id FROM experience_types WHERE id = \\$1 FOR UPDATE").
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint64(1)))
+			dbMock.ExpectExec("DELETE FROM experience_levels WHERE type_id = \\$1").
+				WithArgs(uint64(1)).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			dbMock.ExpectExec(
+				"INSERT INTO experience_levels \\(type_id,level,name,min_duration_seconds\\) VALUES \\(\\$1,\\$2,\\$3,\\$4\\),\\(\\$5,\\$6,\\$7,\\$8\\)",
+			).
+				WithArgs(uint64(1), uint64(1), "junior", int64(0), uint64(1), uint64(2), "middle", int64(3600)).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			dbMock.ExpectCommit()
+
+			err := rep.SetLadder(ctx, 1, models.Ladder{
+				{Level: 1, Name: "junior"},
+				{Level: 2, Name: "middle", MinDuration: time.Hour},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Set level ladder of experience type that does not exist", func() {
+			dbMock.ExpectBegin()
+			dbMock.ExpectQuery("SELECT id FROM experience_types WHERE id = \\$1 FOR UPDATE").
+				WithArgs(uint64(2)).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			dbMock.ExpectRollback()
+
+			err := rep.SetLadder(ctx, 2, nil)
+
+			Expect(err).To(Equal(TypeNotFound))
+		})
+
+		It("Find experiences overlapping ongoing experience", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			dbMock.ExpectPrepare(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE user_id = \\$1 AND type = \\$2 AND id <> \\$3 AND deleted_at IS NULL AND \\(\"to\" IS NULL OR \"to\" > \\$4\\) " +
+					"ORDER BY id ASC FOR UPDATE",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1), uint64(2), uint64(0), from).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(5), uint64(1), uint64(2), from, nil, uint64(1), uint64(1), nil))
+
+			experiences, err := rep.Overlapping(ctx, models.NewExperience(0, 1, 2, from, time.Time{}, 1))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(experiences).To(Equal([]models.Experience{
+				{Id: 5, UserId: 1, Type: 2, From: from, Level: 1, Version: 1},
+			}))
+		})
+
+		It("Find overlapping experiences fails if rows are not read", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			dbMock.ExpectPrepare(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE user_id = \\$1 AND type = \\$2 AND id <> \\$3 AND deleted_at IS NULL AND \\(\"to\" IS NULL OR \"to\" > \\$4\\) " +
+					"ORDER BY id ASC FOR UPDATE",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1), uint64(2), uint64(0), from).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(5), uint64(1), uint64(2), from, nil, uint64(1), uint64(1), nil).
+					AddRow(uint64(6), uint64(1), uint64(2), from, nil, uint64(1), uint64(1), nil).
+					RowError(1, errors.New("connection reset")))
+
+			_, err := rep.Overlapping(ctx, models.NewExperience(0, 1, 2, from, time.Time{}, 1))
+
+			Expect(err).To(MatchError("connection reset"))
+		})
+
+		It("Lock user before finding overlapping experiences in a transaction", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			to := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("SELECT pg_advisory_xact_lock\\(\\$1, \\$2\\)").
+				WithArgs(int32(1), int32(7)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE user_id = \\$1 AND type = \\$2 AND id <> \\$3 AND deleted_at IS NULL AND \\(\"to\" IS NULL OR \"to\" > \\$4\\) " +
+					"AND \"from\" < \\$5 ORDER BY id ASC FOR UPDATE",
+			).
+				WithArgs(uint64(7), uint64(2), uint64(0), from, to).
+				WillReturnRows(sqlmock.NewRows(experienceRows))
+			dbMock.ExpectCommit()
+
+			err := rep.RunInTx(ctx, func(tx IRepo) error {
+				experiences, err := tx.Overlapping(ctx, models.NewExperience(0, 7, 2, from, to, 1))
+				Expect(experiences).To(BeEmpty())
+
+				return err
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Find overlapping experience pairs of user", func() {
+			dbMock.ExpectPrepare(
+				"SELECT a.user_id, a.type, a.id, b.id FROM experiences a " +
+					"JOIN experiences b ON a.user_id = b.user_id AND a.type = b.type AND a.id < b.id " +
+					"WHERE a.deleted_at IS NULL AND b.deleted_at IS NULL " +
+					"AND \\(a.\"to\" IS NULL OR a.\"to\" > b.\"from\"\\) AND \\(b.\"to\" IS NULL OR b.\"to\" > a.\"from\"\\) AND a.user_id = \\$1 " +
+					"ORDER BY a.id ASC, b.id ASC LIMIT 10 OFFSET 0",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"user_id", "type", "id", "id"}).
+					AddRow(uint64(1), uint64(2), uint64(5), uint64(6)))
+
+			overlaps, err := rep.FindOverlaps(ctx, 1, 10, 0)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(overlaps).To(Equal([]models.Overlap{{UserId: 1, Type: 2, FirstId: 5, SecondId: 6}}))
+		})
+
+		It("Lock user experiences in a transaction", func() {
+			from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			dbMock.ExpectBegin()
+			dbMock.ExpectExec("SELECT pg_advisory_xact_lock\\(\\$1, \\$2\\)").
+				WithArgs(int32(1), int32(1)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			dbMock.ExpectQuery(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE user_id = \\$1 AND deleted_at IS NULL ORDER BY id ASC FOR UPDATE",
+			).
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(5), uint64(1), uint64(2), from, nil, uint64(1), uint64(1), nil))
+			dbMock.ExpectCommit()
+
+			var experiences []models.Experience
+
+			err := rep.RunInTx(ctx, func(tx IRepo) error {
+				var err error
+				experiences, err = tx.UserExperiences(ctx, 1)
+
+				return err
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(experiences).To(HaveLen(1))
+		})
+
+		It("Read user experiences out of a transaction without locks", func() {
+			dbMock.ExpectPrepare(
+				"SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences " +
+					"WHERE user_id = \\$1 AND deleted_at IS NULL ORDER BY id ASC$",
+			).
+				ExpectQuery().
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(5), uint64(1), uint64(2), time.Now(), nil, uint64(1), uint64(1), nil))
+
+			experiences, err := rep.UserExperiences(ctx, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(experiences).To(HaveLen(1))
+		})
+
+		It("Reserve idempotency key", func() {
+			record := models.IdempotencyRecord{
+				Method:      "/ocp.experience.api.OcpExperienceApi/CreateExperienceV1",
+				Key:         "key",
+				RequestHash: []byte{1, 2},
+				Lease:       "lease",
+				ExpiresAt:   time.Now(),
+			}
+
+			dbMock.ExpectPrepare(
+				"INSERT INTO idempotency_keys \\(method,key,request_hash,lease,expires_at\\) VALUES \\(\\$1,\\$2,\\$3,\\$4,\\$5\\) " +
+					"ON CONFLICT \\(method, key\\) DO UPDATE SET request_hash = EXCLUDED.request_hash, lease = EXCLUDED.lease, " +
+					"response = NULL, expires_at = EXCLUDED.expires_at WHERE idempotency_keys.expires_at <= \\$6 RETURNING key",
+			).
+				ExpectQuery().
+				WithArgs(record.Method, record.Key, record.RequestHash, record.Lease, record.ExpiresAt.UTC(), sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"key"}))
+
+			reserved, err := rep.ReserveIdempotencyKey(ctx, record)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reserved).To(BeFalse())
+		})
+
+		It("Complete idempotency key reserved by another request", func() {
+			record := models.IdempotencyRecord{
+				Method:    "method",
+				Key:       "key",
+				Lease:     "lease",
+				Response:  []byte{1},
+				ExpiresAt: time.Now(),
+			}
+
+			dbMock.ExpectPrepare(
+				"UPDATE idempotency_keys SET response = \\$1, expires_at = \\$2 " +
+					"WHERE method = \\$3 AND key = \\$4 AND lease = \\$5 AND response IS NULL AND expires_at > \\$6",
+			).
+				ExpectExec().
+				WithArgs(record.Response, record.ExpiresAt.UTC(), "method", "key", "lease", sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			err := rep.CompleteIdempotencyKey(ctx, record)
+
+			Expect(err).To(Equal(IdempotencyLeaseLost))
+		})
+
+		It("Return idempotency key not found", func() {
+			dbMock.ExpectPrepare(
+				"SELECT request_hash, response, expires_at FROM idempotency_keys " +
+					"WHERE method = \\$1 AND key = \\$2 AND expires_at > \\$3",
+			).
+				ExpectQuery().
+				WithArgs("method", "key", sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"request_hash", "response", "expires_at"}))
+
+			_, err := rep.IdempotencyKey(ctx, "method", "key")
+
+			Expect(err).To(Equal(IdempotencyKeyNotFound))
+		})
+
+		It("Purge expired idempotency keys", func() {
+			expiredBefore := time.Now()
+
+			dbMock.ExpectPrepare(
+				"DELETE FROM idempotency_keys WHERE expires_at <= \\$1",
+			).
+				ExpectExec().
+				WithArgs(expiredBefore.UTC()).
+				WillReturnResult(sqlmock.NewResult(0, 3))
+
+			purged, err := rep.PurgeIdempotencyKeys(ctx, expiredBefore)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(purged).To(Equal(uint64(3)))
+		})
+	})
+
+	Context("Routing reads to a replica", func() {
+		const describeSQL = "SELECT id, user_id, type, \"from\", \"to\", level, version, deleted_at FROM experiences WHERE id = \\$1 AND deleted_at IS NULL"
+
+		var (
+			replicaDb   *sql.DB
+			replicaMock sqlmock.Sqlmock
+		)
+
+		JustBeforeEach(func() {
+			var err error
+			db, dbMock, err = sqlmock.New()
+			Expect(err).ToNot(HaveOccurred())
+
+			replicaDb, replicaMock, err = sqlmock.New()
+			Expect(err).ToNot(HaveOccurred())
+
+			rep = NewReplicatedRepo(sqlx.NewDb(db, "sqlmock"), sqlx.NewDb(replicaDb, "sqlmock"))
+		})
+
+		AfterEach(func() {
+			defer replicaDb.Close()
+			Expect(replicaMock.ExpectationsWereMet()).To(Succeed())
+		})
+
+		It("Describe experience from replica", func() {
+			replicaMock.ExpectPrepare(describeSQL).
+				ExpectQuery().
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows(experienceRows).
+					AddRow(uint64(1), uint64(1), uint64(1), time.Time{}, nil, uint64(1), uint64(1), nil))
+
+			experience, err := rep.Describe(ctx, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(experience.Id).To(Equal(uint64(1)))
+		})
+
+		It("Describe experience from primary if read your writes is requested", func() {
+			dbMock.ExpectPrepare(describeSQL).
+				ExpectQuery().
+				WithArgs(uint64(1)).
+				WillReturnRows(sqlmock.NewRows(experienceRows))
+
+			_, err := rep.Describe(consistency.NewContext(ctx), 1)
 			Expect(err).To(Equal(NotFound))
+
+			dbMock.ExpectQuery(describeSQL).
+				WithArgs(uint64(2)).
+				WillReturnRows(sqlmock.NewRows(experienceRows))
+
+			incoming := metadata.NewIncomingContext(ctx, metadata.Pairs(consistency.MetadataKey, "true"))
+			_, err = rep.Describe(incoming, 2)
+			Expect(err).To(Equal(NotFound))
+		})
+
+		It("Count experiences from replica and purge them on primary", func() {
+			replicaMock.ExpectPrepare(
+				"SELECT COUNT\\(\\*\\) FROM experiences WHERE deleted_at IS NULL",
+			).
+				ExpectQuery().
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(uint64(3)))
+
+			count, err := rep.Count(ctx, models.ExperienceFilter{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(uint64(3)))
+
+			deletedBefore := time.Now()
+
+			dbMock.ExpectPrepare(
+				"DELETE FROM experiences WHERE deleted_at < \\$1",
+			).
+				ExpectExec().
+				WithArgs(deletedBefore.UTC()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			purged, err := rep.Purge(ctx, deletedBefore)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(purged).To(Equal(uint64(1)))
 		})
 	})
-})
\ No newline at end of file
+})
@@ -0,0 +1,726 @@
+// Package repotest checks that repo.IRepo implementations behave the same way
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+	"github.com/ozoncp/ocp-experience-api/internal/repo"
+)
+
+// Factory returns an empty repo.IRepo for a single conformance case
+type Factory func(t *testing.T) repo.IRepo
+
+// conformance case
+type testCase struct {
+	name string
+	run  func(g *WithT, r repo.IRepo)
+}
+
+// experience periods used by cases
+var (
+	from = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to   = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// conformance cases, every case gets an empty repo
+var testCases = []testCase{
+	{"Add stores experience with version 1", testAdd},
+	{"AddExperiences returns ids in experiences order", testAddExperiences},
+	{"Describe returns NotFound for missing experience", testDescribeNotFound},
+	{"List sorts by id", testListById},
+	{"List sorts by from with id tie-breaker", testListByFrom},
+	{"List sorts ongoing experiences after ended ones by to", testListByTo},
+	{"List applies limit and offset", testListLimitOffset},
+	{"List continues after cursor", testListAfterCursor},
+	{"List and Count apply filter", testListFilter},
+	{"Remove hides experience until restored", testRemove},
+	{"Remove checks expected version", testRemoveVersion},
+	{"Update writes non-zero values", testUpdateNonZero},
+	{"Update writes listed fields with zero values", testUpdateFields},
+	{"Update returns NotFound and VersionMismatch", testUpdateErrors},
+	{"RemoveExperiences and UpdateExperiences return errors per experience", testBatchErrors},
+	{"RunInTx rolls back on error", testRollback},
+	{"ListHistory returns changes oldest first", testHistory},
+	{"Purge deletes removed experiences", testPurge},
+	{"Type catalog rejects taken names and used types", testTypes},
+	{"Experiences must have types of the type catalog", testTypeReferences},
+	{"SetLadder replaces ladder removed with its type", testLadders},
+	{"Overlapping and FindOverlaps compare experiences of the same user and type", testOverlaps},
+	{"Idempotency key is reserved until it expires", testIdempotencyKeys},
+}
+
+// types of the type catalog every case starts with, experiences of cases have types up to seededTypes
+const seededTypes = 5
+
+// RunConformance runs every conformance case against a repo created by factory
+func RunConformance(t *testing.T, factory Factory) {
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			r := factory(t)
+
+			seedTypes(g, r)
+			tc.run(g, r)
+		})
+	}
+}
+
+// seedTypes adds types with ids from 1 to seededTypes to the empty type catalog
+func seedTypes(g *WithT, r repo.IRepo) {
+	for id := uint64(1); id <= seededTypes; id++ {
+		typeId, err := r.AddType(context.Background(), models.ExperienceType{Name: fmt.Sprintf("type %d", id)})
+
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(typeId).To(Equal(id))
+	}
+}
+
+// byIdOrder sorts experiences by id
+var byIdOrder = models.ExperienceOrder{Field: models.OrderById}
+
+// add stores experiences and returns their ids
+func add(g *WithT, r repo.IRepo, experiences ...models.Experience) []uint64 {
+	ids, err := r.AddExperiences(context.Background(), experiences)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ids).To(HaveLen(len(experiences)))
+
+	return ids
+}
+
+// experienceIds returns ids of experiences
+func experienceIds(experiences []models.Experience) []uint64 {
+	ids := make([]uint64, 0, len(experiences))
+
+	for _, experience := range experiences {
+		ids = append(ids, experience.Id)
+	}
+
+	return ids
+}
+
+func testAdd(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	id, err := r.Add(ctx, models.NewExperience(0, 1, 2, from, to, 3))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(id).ToNot(BeZero())
+
+	experience, err := r.Describe(ctx, id)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.Id).To(Equal(id))
+	g.Expect(experience.UserId).To(Equal(uint64(1)))
+	g.Expect(experience.Type).To(Equal(uint64(2)))
+	g.Expect(experience.From).To(BeTemporally("==", from))
+	g.Expect(experience.To).To(BeTemporally("==", to))
+	g.Expect(experience.Level).To(Equal(uint64(3)))
+	g.Expect(experience.Version).To(Equal(uint64(1)))
+	g.Expect(experience.DeletedAt.IsZero()).To(BeTrue())
+}
+
+func testAddExperiences(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 2, 1, from, time.Time{}, 2),
+	)
+
+	g.Expect(ids[0]).To(BeNumerically("<", ids[1]))
+
+	experience, err := r.Describe(ctx, ids[1])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.UserId).To(Equal(uint64(2)))
+	g.Expect(experience.IsOngoing()).To(BeTrue())
+}
+
+func testDescribeNotFound(g *WithT, r repo.IRepo) {
+	_, err := r.Describe(context.Background(), 1000)
+
+	g.Expect(errors.Is(err, repo.NotFound)).To(BeTrue())
+}
+
+func testListById(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+	)
+
+	experiences, err := r.List(ctx, models.ExperienceFilter{}, byIdOrder, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal(ids))
+
+	desc := models.ExperienceOrder{Field: models.OrderById, Desc: true}
+	experiences, err = r.List(ctx, models.ExperienceFilter{}, desc, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[2], ids[1], ids[0]}))
+}
+
+func testListByFrom(g *WithT, r repo.IRepo) {
+	later := from.Add(time.Hour)
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, later, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, later, to, 1),
+	)
+
+	order := models.ExperienceOrder{Field: models.OrderByFrom}
+	experiences, err := r.List(context.Background(), models.ExperienceFilter{}, order, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[1], ids[0], ids[2]}))
+}
+
+func testListByTo(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, time.Time{}, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, from.Add(time.Hour), 1),
+	)
+
+	order := models.ExperienceOrder{Field: models.OrderByTo}
+	experiences, err := r.List(ctx, models.ExperienceFilter{}, order, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[2], ids[1], ids[0]}))
+
+	order.Desc = true
+	experiences, err = r.List(ctx, models.ExperienceFilter{}, order, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[0], ids[1], ids[2]}))
+}
+
+func testListLimitOffset(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+	)
+
+	experiences, err := r.List(ctx, models.ExperienceFilter{}, byIdOrder, nil, 2, 1)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal(ids[1:]))
+
+	experiences, err = r.List(ctx, models.ExperienceFilter{}, byIdOrder, nil, 2, 3)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experiences).To(BeEmpty())
+}
+
+func testListAfterCursor(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 3),
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 2),
+	)
+
+	order := models.ExperienceOrder{Field: models.OrderByLevel}
+	experiences, err := r.List(ctx, models.ExperienceFilter{}, order, nil, 1, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[1]}))
+
+	cursor := models.NewCursor(order, experiences[0])
+	experiences, err = r.List(ctx, models.ExperienceFilter{}, order, &cursor, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[2], ids[0]}))
+}
+
+func testListFilter(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 2, 1, from, to, 1),
+		models.NewExperience(0, 2, 2, from, time.Time{}, 3),
+		models.NewExperience(0, 2, 1, from, to, 5),
+	)
+
+	removed, err := r.Remove(ctx, ids[3], 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	filter := models.ExperienceFilter{UserId: 2}
+	experiences, err := r.List(ctx, filter, byIdOrder, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[1], ids[2]}))
+
+	count, err := r.Count(ctx, filter)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(count).To(Equal(uint64(2)))
+
+	filter.IncludeDeleted = true
+	count, err = r.Count(ctx, filter)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(count).To(Equal(uint64(3)))
+
+	filter = models.ExperienceFilter{OngoingOnly: true}
+	experiences, err = r.List(ctx, filter, byIdOrder, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[2]}))
+
+	filter = models.ExperienceFilter{Types: []uint64{1}, MinLevel: 1, MaxLevel: 2}
+	experiences, err = r.List(ctx, filter, byIdOrder, nil, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(experiences)).To(Equal([]uint64{ids[0], ids[1]}))
+}
+
+func testRemove(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 1, from, to, 1))
+
+	removed, err := r.Remove(ctx, ids[0], 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	_, err = r.Describe(ctx, ids[0])
+	g.Expect(errors.Is(err, repo.NotFound)).To(BeTrue())
+
+	removed, err = r.Remove(ctx, ids[0], 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeFalse())
+
+	restored, err := r.Restore(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(restored).To(BeTrue())
+
+	experience, err := r.Describe(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.Version).To(Equal(uint64(3)))
+
+	restored, err = r.Restore(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(restored).To(BeFalse())
+}
+
+func testRemoveVersion(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 1, from, to, 1))
+
+	_, err := r.Remove(ctx, ids[0], 2)
+	g.Expect(errors.Is(err, repo.VersionMismatch)).To(BeTrue())
+
+	removed, err := r.Remove(ctx, ids[0], 1)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+}
+
+func testUpdateNonZero(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 2, from, to, 1))
+
+	g.Expect(r.Update(ctx, models.Experience{Id: ids[0], Level: 3}, nil, 0)).To(Succeed())
+
+	experience, err := r.Describe(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.UserId).To(Equal(uint64(1)))
+	g.Expect(experience.Type).To(Equal(uint64(2)))
+	g.Expect(experience.From).To(BeTemporally("==", from))
+	g.Expect(experience.To).To(BeTemporally("==", to))
+	g.Expect(experience.Level).To(Equal(uint64(3)))
+	g.Expect(experience.Version).To(Equal(uint64(2)))
+}
+
+func testUpdateFields(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 2, from, to, 1))
+	update := models.Experience{Id: ids[0], Type: 5}
+
+	g.Expect(r.Update(ctx, update, []string{models.ToField, models.LevelField}, 1)).To(Succeed())
+
+	experience, err := r.Describe(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.Type).To(Equal(uint64(2)))
+	g.Expect(experience.IsOngoing()).To(BeTrue())
+	g.Expect(experience.Level).To(BeZero())
+	g.Expect(experience.Version).To(Equal(uint64(2)))
+}
+
+func testUpdateErrors(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 1, from, to, 1))
+
+	err := r.Update(ctx, models.Experience{Id: ids[0] + 1000, Level: 2}, nil, 0)
+	g.Expect(errors.Is(err, repo.NotFound)).To(BeTrue())
+
+	err = r.Update(ctx, models.Experience{Id: ids[0], Level: 2}, nil, 5)
+	g.Expect(errors.Is(err, repo.VersionMismatch)).To(BeTrue())
+
+	removed, err := r.Remove(ctx, ids[0], 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	err = r.Update(ctx, models.Experience{Id: ids[0], Level: 2}, nil, 0)
+	g.Expect(errors.Is(err, repo.NotFound)).To(BeTrue())
+}
+
+func testBatchErrors(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+	)
+
+	errs, err := r.UpdateExperiences(ctx, []models.Experience{
+		{Id: ids[0], Level: 2, Version: 1},
+		{Id: ids[1], Level: 2, Version: 3},
+		{Id: ids[1] + 1000, Level: 2},
+	}, nil)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(errs).To(HaveLen(3))
+	g.Expect(errs[0]).ToNot(HaveOccurred())
+	g.Expect(errors.Is(errs[1], repo.VersionMismatch)).To(BeTrue())
+	g.Expect(errors.Is(errs[2], repo.NotFound)).To(BeTrue())
+
+	errs, err = r.RemoveExperiences(ctx, []models.Experience{
+		{Id: ids[0], Version: 2},
+		{Id: ids[1] + 1000},
+	})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0]).ToNot(HaveOccurred())
+	g.Expect(errors.Is(errs[1], repo.NotFound)).To(BeTrue())
+
+	count, err := r.Count(ctx, models.ExperienceFilter{})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(count).To(Equal(uint64(1)))
+}
+
+func testRollback(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 1, from, to, 1))
+	expectedError := errors.New("test error")
+
+	err := r.RunInTx(ctx, func(tx repo.IRepo) error {
+		if _, err := tx.Add(ctx, models.NewExperience(0, 1, 1, from, to, 1)); err != nil {
+			return err
+		}
+
+		if err := tx.Update(ctx, models.Experience{Id: ids[0], Level: 2}, nil, 0); err != nil {
+			return err
+		}
+
+		return expectedError
+	})
+
+	g.Expect(err).To(Equal(expectedError))
+
+	count, err := r.Count(ctx, models.ExperienceFilter{})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(count).To(Equal(uint64(1)))
+
+	experience, err := r.Describe(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.Level).To(Equal(uint64(1)))
+	g.Expect(experience.Version).To(Equal(uint64(1)))
+}
+
+func testHistory(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r, models.NewExperience(0, 1, 1, from, to, 1))
+
+	g.Expect(r.Update(ctx, models.Experience{Id: ids[0], Level: 2}, nil, 0)).To(Succeed())
+
+	removed, err := r.Remove(ctx, ids[0], 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	records, err := r.ListHistory(ctx, ids[0], 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(records).To(HaveLen(3))
+	g.Expect(records[0].Action).To(Equal(models.CreateAction))
+	g.Expect(records[0].Before).To(BeNil())
+	g.Expect(records[1].Action).To(Equal(models.UpdateAction))
+	g.Expect(records[1].Before.Level).To(Equal(uint64(1)))
+	g.Expect(records[1].After.Level).To(Equal(uint64(2)))
+	g.Expect(records[2].Action).To(Equal(models.RemoveAction))
+}
+
+func testPurge(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, from, to, 1),
+	)
+
+	removed, err := r.Remove(ctx, ids[0], 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	purged, err := r.Purge(ctx, time.Now().Add(-time.Hour))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(purged).To(BeZero())
+
+	purged, err = r.Purge(ctx, time.Now().Add(time.Hour))
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(purged).To(Equal(uint64(1)))
+
+	restored, err := r.Restore(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(restored).To(BeFalse())
+}
+
+func testTypes(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	backend, err := r.AddType(ctx, models.ExperienceType{Name: "backend"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	frontend, err := r.AddType(ctx, models.ExperienceType{Name: "frontend"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = r.AddType(ctx, models.ExperienceType{Name: "backend"})
+	g.Expect(errors.Is(err, repo.TypeExists)).To(BeTrue())
+
+	err = r.UpdateType(ctx, models.ExperienceType{Id: frontend, Name: "backend"})
+	g.Expect(errors.Is(err, repo.TypeExists)).To(BeTrue())
+
+	g.Expect(r.UpdateType(ctx, models.ExperienceType{Id: frontend, Name: "web"})).To(Succeed())
+
+	err = r.UpdateType(ctx, models.ExperienceType{Id: frontend + 1000, Name: "mobile"})
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+
+	types, err := r.ListTypes(ctx, 10, seededTypes)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(types).To(Equal([]models.ExperienceType{{Id: backend, Name: "backend"}, {Id: frontend, Name: "web"}}))
+
+	names, err := r.TypeNames(ctx, []uint64{frontend, frontend + 1000})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(names).To(Equal(map[uint64]string{frontend: "web"}))
+
+	add(g, r, models.NewExperience(0, 1, backend, from, to, 1))
+
+	_, err = r.RemoveType(ctx, backend)
+	g.Expect(errors.Is(err, repo.TypeInUse)).To(BeTrue())
+
+	removed, err := r.RemoveType(ctx, frontend)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	_, err = r.DescribeType(ctx, frontend)
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+}
+
+func testTypeReferences(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	missing := uint64(seededTypes + 1000)
+
+	_, err := r.Add(ctx, models.NewExperience(0, 1, missing, from, to, 1))
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+
+	_, err = r.AddExperiences(ctx, []models.Experience{
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, missing, from, to, 1),
+	})
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+
+	count, err := r.Count(ctx, models.ExperienceFilter{})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(count).To(BeZero())
+
+	ids := add(g, r, models.NewExperience(0, 1, 1, from, to, 1))
+
+	err = r.Update(ctx, models.Experience{Id: ids[0], Type: missing}, nil, 0)
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+
+	_, err = r.UpdateExperiences(ctx, []models.Experience{{Id: ids[0], Type: missing}}, nil)
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+
+	experience, err := r.Describe(ctx, ids[0])
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experience.Type).To(Equal(uint64(1)))
+	g.Expect(experience.Version).To(Equal(uint64(1)))
+}
+
+func testLadders(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	typeId, err := r.AddType(ctx, models.ExperienceType{Name: "backend"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = r.SetLadder(ctx, typeId+1000, models.Ladder{{Level: 1, Name: "junior"}})
+	g.Expect(errors.Is(err, repo.TypeNotFound)).To(BeTrue())
+
+	g.Expect(r.SetLadder(ctx, typeId, models.Ladder{
+		{Level: 2, Name: "middle", MinDuration: time.Hour},
+		{Level: 1, Name: "junior"},
+	})).To(Succeed())
+
+	ladders, err := r.Ladders(ctx, []uint64{typeId})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ladders).To(Equal(map[uint64]models.Ladder{typeId: {
+		{TypeId: typeId, Level: 1, Name: "junior"},
+		{TypeId: typeId, Level: 2, Name: "middle", MinDuration: time.Hour},
+	}}))
+
+	g.Expect(r.SetLadder(ctx, typeId, models.Ladder{{Level: 3, Name: "senior"}})).To(Succeed())
+
+	ladders, err = r.Ladders(ctx, []uint64{typeId})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ladders[typeId]).To(Equal(models.Ladder{{TypeId: typeId, Level: 3, Name: "senior"}}))
+
+	removed, err := r.RemoveType(ctx, typeId)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(BeTrue())
+
+	ladders, err = r.Ladders(ctx, []uint64{typeId})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ladders).To(BeEmpty())
+}
+
+func testOverlaps(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	middle := from.Add(24 * time.Hour)
+	ids := add(g, r,
+		models.NewExperience(0, 1, 1, from, to, 1),
+		models.NewExperience(0, 1, 1, middle, time.Time{}, 1),
+		models.NewExperience(0, 1, 2, middle, to, 1),
+		models.NewExperience(0, 2, 1, middle, to, 1),
+		models.NewExperience(0, 1, 1, to, to.Add(time.Hour), 1),
+	)
+
+	overlapping, err := r.Overlapping(ctx, models.Experience{Id: ids[0], UserId: 1, Type: 1, From: from, To: to})
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(experienceIds(overlapping)).To(Equal([]uint64{ids[1]}))
+
+	overlaps, err := r.FindOverlaps(ctx, 0, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(overlaps).To(Equal([]models.Overlap{
+		{UserId: 1, Type: 1, FirstId: ids[0], SecondId: ids[1]},
+		{UserId: 1, Type: 1, FirstId: ids[1], SecondId: ids[4]},
+	}))
+
+	overlaps, err = r.FindOverlaps(ctx, 2, 10, 0)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(overlaps).To(BeEmpty())
+}
+
+func testIdempotencyKeys(g *WithT, r repo.IRepo) {
+	ctx := context.Background()
+	record := models.IdempotencyRecord{
+		Method:      "method",
+		Key:         "key",
+		RequestHash: []byte{1, 2, 3},
+		Lease:       "lease",
+		ExpiresAt:   time.Now().Add(time.Minute),
+	}
+
+	reserved, err := r.ReserveIdempotencyKey(ctx, record)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reserved).To(BeTrue())
+
+	reserved, err = r.ReserveIdempotencyKey(ctx, record)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reserved).To(BeFalse())
+
+	completed := record
+	completed.Response = []byte{4}
+	completed.ExpiresAt = time.Now().Add(time.Hour)
+
+	foreign := completed
+	foreign.Lease = "another"
+
+	g.Expect(r.CompleteIdempotencyKey(ctx, foreign)).To(MatchError(repo.IdempotencyLeaseLost))
+	g.Expect(r.ReleaseIdempotencyKey(ctx, foreign)).To(Succeed())
+
+	g.Expect(r.CompleteIdempotencyKey(ctx, completed)).To(Succeed())
+	g.Expect(r.CompleteIdempotencyKey(ctx, completed)).To(MatchError(repo.IdempotencyLeaseLost))
+	g.Expect(r.ReleaseIdempotencyKey(ctx, completed)).To(Succeed())
+
+	stored, err := r.IdempotencyKey(ctx, "method", "key")
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stored.RequestHash).To(Equal(record.RequestHash))
+	g.Expect(stored.Response).To(Equal([]byte{4}))
+	g.Expect(stored.ExpiresAt).To(BeTemporally("~", completed.ExpiresAt, time.Second))
+
+	_, err = r.IdempotencyKey(ctx, "another", "key")
+	g.Expect(errors.Is(err, repo.IdempotencyKeyNotFound)).To(BeTrue())
+
+	expired := record
+	expired.Key = "expired"
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+
+	reserved, err = r.ReserveIdempotencyKey(ctx, expired)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reserved).To(BeTrue())
+
+	expiredResponse := expired
+	expiredResponse.Response = []byte{5}
+	expiredResponse.ExpiresAt = time.Now().Add(time.Hour)
+
+	g.Expect(r.CompleteIdempotencyKey(ctx, expiredResponse)).To(MatchError(repo.IdempotencyLeaseLost))
+
+	retried := expired
+	retried.Lease = "retried"
+
+	reserved, err = r.ReserveIdempotencyKey(ctx, retried)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reserved).To(BeTrue())
+
+	g.Expect(r.ReleaseIdempotencyKey(ctx, expired)).To(Succeed())
+
+	purged, err := r.PurgeIdempotencyKeys(ctx, time.Now())
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(purged).To(Equal(uint64(1)))
+}
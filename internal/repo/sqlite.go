@@ -0,0 +1,18 @@
+package repo
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// NewSQLiteRepo creates a Repo using SQLite database with migrations/sqlite schema.
+// SQLite has no row locks, db should be connected with db.ConnectSQLite to serialize transactions
+func NewSQLiteRepo(db *sqlx.DB) *Repo {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Question).RunWith(sq.NewStmtCache(db))
+
+	return &Repo{
+		db:      db,
+		builder: builder,
+		replica: builder,
+	}
+}
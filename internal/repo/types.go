@@ -0,0 +1,239 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ozoncp/ocp-experience-api/internal/models"
+)
+
+var TypeNotFound = errors.New("experience type does not exist")
+var TypeExists = errors.New("experience type with the name already exists")
+var TypeInUse = errors.New("experience type is used by experiences")
+
+// AddType adds experience type to the type catalog and returns its id.
+// Returns TypeExists error if the name is taken
+func (r *Repo) AddType(ctx context.Context, experienceType models.ExperienceType) (uint64, error) {
+	rows, err := r.builder.Insert("experience_types").
+		Columns("name").
+		Values(experienceType.Name).
+		Suffix("ON CONFLICT (name) DO NOTHING RETURNING id").
+		QueryContext(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+
+		return 0, TypeExists
+	}
+
+	var id uint64 = 0
+
+	if err := rows.Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListTypes returns experience types sorted by id
+func (r *Repo) ListTypes(ctx context.Context, limit, offset uint64) ([]models.ExperienceType, error) {
+	rows, err := r.reader(ctx).Select("id, name").
+		From("experience_types").
+		OrderBy("id ASC").
+		Offset(offset).
+		Limit(limit).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	types := make([]models.ExperienceType, 0, limit)
+
+	for rows.Next() {
+		var experienceType models.ExperienceType
+
+		if err := rows.Scan(&experienceType.Id, &experienceType.Name); err != nil {
+			return nil, err
+		}
+
+		types = append(types, experienceType)
+	}
+
+	return types, rows.Err()
+}
+
+// DescribeType returns experience type by id, returns TypeNotFound error if there is none
+func (r *Repo) DescribeType(ctx context.Context, id uint64) (models.ExperienceType, error) {
+	rows, err := r.builder.Select("id, name").
+		From("experience_types").
+		Where("id = ?", id).
+		QueryContext(ctx)
+
+	if err != nil {
+		return models.ExperienceType{}, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return models.ExperienceType{}, err
+		}
+
+		return models.ExperienceType{}, TypeNotFound
+	}
+
+	var experienceType models.ExperienceType
+	err = rows.Scan(&experienceType.Id, &experienceType.Name)
+
+	return experienceType, err
+}
+
+// UpdateType renames experience type. Returns TypeNotFound error if there is no type,
+// TypeExists error if the name is taken by another type
+func (r *Repo) UpdateType(ctx context.Context, experienceType models.ExperienceType) error {
+	return r.inTx(ctx, func(tx sq.BaseRunner) error {
+		builder := r.builder.RunWith(tx)
+
+		rows, err := builder.Select("id").
+			From("experience_types").
+			Where("name = ?", experienceType.Name).
+			Where("id <> ?", experienceType.Id).
+			QueryContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		taken, err := exists(rows)
+
+		if err != nil {
+			return err
+		}
+
+		if taken {
+			return TypeExists
+		}
+
+		ret, err := builder.Update("experience_types").
+			Set("name", experienceType.Name).
+			Where("id = ?", experienceType.Id).
+			ExecContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		rowsUpdated, err := ret.RowsAffected()
+
+		if err != nil {
+			return err
+		}
+
+		if rowsUpdated == 0 {
+			return TypeNotFound
+		}
+
+		return nil
+	})
+}
+
+// RemoveType removes experience type by id. Returns TypeInUse error if experiences
+// including removed ones have the type. Experiences reference their types by a foreign key,
+// so experiences written concurrently can not get the removed type
+func (r *Repo) RemoveType(ctx context.Context, id uint64) (bool, error) {
+	removed := false
+
+	err := r.inTx(ctx, func(tx sq.BaseRunner) error {
+		builder := r.builder.RunWith(tx)
+
+		rows, err := builder.Select("id").
+			From("experiences").
+			Where("type = ?", id).
+			Limit(1).
+			QueryContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		used, err := exists(rows)
+
+		if err != nil {
+			return err
+		}
+
+		if used {
+			return TypeInUse
+		}
+
+		ret, err := builder.Delete("experience_types").
+			Where("id = ?", id).
+			ExecContext(ctx)
+
+		if isForeignKeyViolation(err) {
+			return TypeInUse
+		}
+
+		if err != nil {
+			return err
+		}
+
+		rowsDeleted, err := ret.RowsAffected()
+
+		if err != nil {
+			return err
+		}
+
+		removed = rowsDeleted > 0
+		return nil
+	})
+
+	return removed, err
+}
+
+// TypeNames returns names of experience types by id, types missing in the catalog are not returned
+func (r *Repo) TypeNames(ctx context.Context, ids []uint64) (map[uint64]string, error) {
+	names := make(map[uint64]string, len(ids))
+
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	rows, err := r.builder.Select("id, name").
+		From("experience_types").
+		Where(sq.Eq{"id": ids}).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		var name string
+
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+
+		names[id] = name
+	}
+
+	return names, rows.Err()
+}
@@ -119,7 +119,7 @@ const loopFileName = "./test_loop_file.txt"
 
 // creates and writes test file by file name (file path)
 func createTestLoopFile(fileName string) error {
-	file, err := os.OpenFile(fileName, os.O_CREATE, os.ModePerm)
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY, os.ModePerm)
 
 	if err != nil {
 		return err
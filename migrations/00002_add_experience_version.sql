@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE experiences ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE experiences DROP COLUMN IF EXISTS version;
+-- +goose StatementBegin
+-- +goose StatementEnd
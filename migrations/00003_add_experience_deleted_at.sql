@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE experiences ADD COLUMN deleted_at TIMESTAMP(0) WITH TIME ZONE NULL;
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE experiences DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE experience_history
+(
+    id            BIGSERIAL PRIMARY KEY,
+    experience_id BIGINT NOT NULL,
+    action        TEXT NOT NULL,
+    actor         TEXT NOT NULL,
+    before        JSONB NULL,
+    after         JSONB NULL,
+    created_at    TIMESTAMP(0) WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX experience_history_experience_id_idx ON experience_history (experience_id, id);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS experience_history;
+-- +goose StatementBegin
+-- +goose StatementEnd
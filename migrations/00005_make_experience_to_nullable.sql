@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE experiences ALTER COLUMN "to" DROP NOT NULL;
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+UPDATE experiences SET "to" = now() WHERE "to" IS NULL;
+ALTER TABLE experiences ALTER COLUMN "to" SET NOT NULL;
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE experience_types
+(
+    id   BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS experience_types;
+-- +goose StatementBegin
+-- +goose StatementEnd
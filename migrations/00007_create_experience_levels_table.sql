@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE experience_levels
+(
+    type_id              BIGINT NOT NULL REFERENCES experience_types (id) ON DELETE CASCADE,
+    level                BIGINT NOT NULL,
+    name                 TEXT   NOT NULL,
+    min_duration_seconds BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (type_id, level),
+    UNIQUE (type_id, name)
+);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS experience_levels;
+-- +goose StatementBegin
+-- +goose StatementEnd
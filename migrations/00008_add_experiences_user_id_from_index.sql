@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE INDEX experiences_user_id_from_idx ON experiences (user_id, "from", id);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS experiences_user_id_from_idx;
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE idempotency_keys
+(
+    method       TEXT                     NOT NULL,
+    key          TEXT                     NOT NULL,
+    request_hash BYTEA                    NOT NULL,
+    response     BYTEA,
+    expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (method, key)
+);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementBegin
+-- +goose StatementEnd
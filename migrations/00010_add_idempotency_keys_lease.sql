@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE idempotency_keys ADD COLUMN lease TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS lease;
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+INSERT INTO experience_types (id, name)
+SELECT DISTINCT type, 'type ' || type
+FROM experiences
+ON CONFLICT DO NOTHING;
+
+SELECT setval(pg_get_serial_sequence('experience_types', 'id'), COALESCE(MAX(id), 0) + 1, false)
+FROM experience_types;
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+-- seeded types are kept, they may be renamed or used by experiences
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE INDEX experiences_type_idx ON experiences (type);
+ALTER TABLE experiences ADD CONSTRAINT experiences_type_fkey FOREIGN KEY (type) REFERENCES experience_types (id);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE experiences DROP CONSTRAINT IF EXISTS experiences_type_fkey;
+DROP INDEX IF EXISTS experiences_type_idx;
+-- +goose StatementBegin
+-- +goose StatementEnd
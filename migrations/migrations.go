@@ -0,0 +1,12 @@
+// Package migrations embeds SQL migrations applied by goose.
+package migrations
+
+import "embed"
+
+// Postgres contains PostgreSQL migrations in the root directory
+//go:embed *.sql
+var Postgres embed.FS
+
+// SQLite contains SQLite migrations in the sqlite directory
+//go:embed sqlite/*.sql
+var SQLite embed.FS
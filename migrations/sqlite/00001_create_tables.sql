@@ -0,0 +1,65 @@
+-- +goose Up
+CREATE TABLE experiences
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER   NOT NULL,
+    type       INTEGER   NOT NULL,
+    "from"     TIMESTAMP NOT NULL,
+    "to"       TIMESTAMP NULL,
+    level      INTEGER   NOT NULL,
+    version    INTEGER   NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP NULL
+);
+
+CREATE INDEX experiences_user_id_from_idx ON experiences (user_id, "from", id);
+
+CREATE TABLE experience_history
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    experience_id INTEGER   NOT NULL,
+    action        TEXT      NOT NULL,
+    actor         TEXT      NOT NULL,
+    before        TEXT      NULL,
+    after         TEXT      NULL,
+    created_at    TIMESTAMP NOT NULL
+);
+
+CREATE INDEX experience_history_experience_id_idx ON experience_history (experience_id, id);
+
+CREATE TABLE experience_types
+(
+    id   INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE experience_levels
+(
+    type_id              INTEGER NOT NULL REFERENCES experience_types (id) ON DELETE CASCADE,
+    level                INTEGER NOT NULL,
+    name                 TEXT    NOT NULL,
+    min_duration_seconds INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (type_id, level),
+    UNIQUE (type_id, name)
+);
+
+CREATE TABLE idempotency_keys
+(
+    method       TEXT      NOT NULL,
+    key          TEXT      NOT NULL,
+    request_hash BLOB      NOT NULL,
+    response     BLOB,
+    expires_at   TIMESTAMP NOT NULL,
+    PRIMARY KEY (method, key)
+);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
+DROP TABLE IF EXISTS experience_levels;
+DROP TABLE IF EXISTS experience_types;
+DROP TABLE IF EXISTS experience_history;
+DROP TABLE IF EXISTS experiences;
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+INSERT OR IGNORE INTO experience_types (id, name)
+SELECT DISTINCT type, 'type ' || type
+FROM experiences;
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+-- seeded types are kept, they may be renamed or used by experiences
+-- +goose StatementBegin
+-- +goose StatementEnd
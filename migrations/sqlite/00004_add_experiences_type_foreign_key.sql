@@ -0,0 +1,56 @@
+-- +goose Up
+CREATE TABLE experiences_typed
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER   NOT NULL,
+    type       INTEGER   NOT NULL REFERENCES experience_types (id),
+    "from"     TIMESTAMP NOT NULL,
+    "to"       TIMESTAMP NULL,
+    level      INTEGER   NOT NULL,
+    version    INTEGER   NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP NULL
+);
+
+INSERT INTO experiences_typed (id, user_id, type, "from", "to", level, version, deleted_at)
+SELECT id, user_id, type, "from", "to", level, version, deleted_at
+FROM experiences;
+
+-- ids of purged experiences are not reused
+DELETE FROM sqlite_sequence WHERE name = 'experiences_typed';
+INSERT INTO sqlite_sequence (name, seq) SELECT 'experiences_typed', seq FROM sqlite_sequence WHERE name = 'experiences';
+
+DROP TABLE experiences;
+ALTER TABLE experiences_typed RENAME TO experiences;
+
+CREATE INDEX experiences_user_id_from_idx ON experiences (user_id, "from", id);
+CREATE INDEX experiences_type_idx ON experiences (type);
+
+-- +goose StatementBegin
+-- +goose StatementEnd
+
+-- +goose Down
+CREATE TABLE experiences_untyped
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER   NOT NULL,
+    type       INTEGER   NOT NULL,
+    "from"     TIMESTAMP NOT NULL,
+    "to"       TIMESTAMP NULL,
+    level      INTEGER   NOT NULL,
+    version    INTEGER   NOT NULL DEFAULT 1,
+    deleted_at TIMESTAMP NULL
+);
+
+INSERT INTO experiences_untyped (id, user_id, type, "from", "to", level, version, deleted_at)
+SELECT id, user_id, type, "from", "to", level, version, deleted_at
+FROM experiences;
+
+DELETE FROM sqlite_sequence WHERE name = 'experiences_untyped';
+INSERT INTO sqlite_sequence (name, seq) SELECT 'experiences_untyped', seq FROM sqlite_sequence WHERE name = 'experiences';
+
+DROP TABLE experiences;
+ALTER TABLE experiences_untyped RENAME TO experiences;
+
+CREATE INDEX experiences_user_id_from_idx ON experiences (user_id, "from", id);
+-- +goose StatementBegin
+-- +goose StatementEnd
@@ -10,8 +10,10 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +25,230 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExperienceOrder_Field int32
+
+const (
+	ExperienceOrder_ID      ExperienceOrder_Field = 0
+	ExperienceOrder_USER_ID ExperienceOrder_Field = 1
+	ExperienceOrder_TYPE    ExperienceOrder_Field = 2
+	ExperienceOrder_FROM    ExperienceOrder_Field = 3
+	ExperienceOrder_TO      ExperienceOrder_Field = 4
+	ExperienceOrder_LEVEL   ExperienceOrder_Field = 5
+)
+
+// Enum value maps for ExperienceOrder_Field.
+var (
+	ExperienceOrder_Field_name = map[int32]string{
+		0: "ID",
+		1: "USER_ID",
+		2: "TYPE",
+		3: "FROM",
+		4: "TO",
+		5: "LEVEL",
+	}
+	ExperienceOrder_Field_value = map[string]int32{
+		"ID":      0,
+		"USER_ID": 1,
+		"TYPE":    2,
+		"FROM":    3,
+		"TO":      4,
+		"LEVEL":   5,
+	}
+)
+
+func (x ExperienceOrder_Field) Enum() *ExperienceOrder_Field {
+	p := new(ExperienceOrder_Field)
+	*p = x
+	return p
+}
+
+func (x ExperienceOrder_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExperienceOrder_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[0].Descriptor()
+}
+
+func (ExperienceOrder_Field) Type() protoreflect.EnumType {
+	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[0]
+}
+
+func (x ExperienceOrder_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExperienceOrder_Field.Descriptor instead.
+func (ExperienceOrder_Field) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type ExperienceHistoryRecord_Action int32
+
+const (
+	ExperienceHistoryRecord_CREATE  ExperienceHistoryRecord_Action = 0
+	ExperienceHistoryRecord_UPDATE  ExperienceHistoryRecord_Action = 1
+	ExperienceHistoryRecord_REMOVE  ExperienceHistoryRecord_Action = 2
+	ExperienceHistoryRecord_RESTORE ExperienceHistoryRecord_Action = 3
+)
+
+// Enum value maps for ExperienceHistoryRecord_Action.
+var (
+	ExperienceHistoryRecord_Action_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "REMOVE",
+		3: "RESTORE",
+	}
+	ExperienceHistoryRecord_Action_value = map[string]int32{
+		"CREATE":  0,
+		"UPDATE":  1,
+		"REMOVE":  2,
+		"RESTORE": 3,
+	}
+)
+
+func (x ExperienceHistoryRecord_Action) Enum() *ExperienceHistoryRecord_Action {
+	p := new(ExperienceHistoryRecord_Action)
+	*p = x
+	return p
+}
+
+func (x ExperienceHistoryRecord_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExperienceHistoryRecord_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ExperienceHistoryRecord_Action) Type() protoreflect.EnumType {
+	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[1]
+}
+
+func (x ExperienceHistoryRecord_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExperienceHistoryRecord_Action.Descriptor instead.
+func (ExperienceHistoryRecord_Action) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type MultiCreateExperienceV1Request_Mode int32
+
+const (
+	// every batch is committed separately, creating stops at the first failed batch
+	MultiCreateExperienceV1Request_BATCHED MultiCreateExperienceV1Request_Mode = 0
+	// all batches are committed in one transaction, nothing is created on error
+	MultiCreateExperienceV1Request_ATOMIC MultiCreateExperienceV1Request_Mode = 1
+	// failed batches are retried item by item, a result is returned per experience,
+	// invalid experiences are reported in their results and the valid ones are still created
+	MultiCreateExperienceV1Request_BEST_EFFORT MultiCreateExperienceV1Request_Mode = 2
+)
+
+// Enum value maps for MultiCreateExperienceV1Request_Mode.
+var (
+	MultiCreateExperienceV1Request_Mode_name = map[int32]string{
+		0: "BATCHED",
+		1: "ATOMIC",
+		2: "BEST_EFFORT",
+	}
+	MultiCreateExperienceV1Request_Mode_value = map[string]int32{
+		"BATCHED":     0,
+		"ATOMIC":      1,
+		"BEST_EFFORT": 2,
+	}
+)
+
+func (x MultiCreateExperienceV1Request_Mode) Enum() *MultiCreateExperienceV1Request_Mode {
+	p := new(MultiCreateExperienceV1Request_Mode)
+	*p = x
+	return p
+}
+
+func (x MultiCreateExperienceV1Request_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MultiCreateExperienceV1Request_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[2].Descriptor()
+}
+
+func (MultiCreateExperienceV1Request_Mode) Type() protoreflect.EnumType {
+	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[2]
+}
+
+func (x MultiCreateExperienceV1Request_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MultiCreateExperienceV1Request_Mode.Descriptor instead.
+func (MultiCreateExperienceV1Request_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type ExperienceBatchResult_Status int32
+
+const (
+	// experience is removed or updated
+	ExperienceBatchResult_OK               ExperienceBatchResult_Status = 0
+	ExperienceBatchResult_NOT_FOUND        ExperienceBatchResult_Status = 1
+	ExperienceBatchResult_VERSION_MISMATCH ExperienceBatchResult_Status = 2
+	ExperienceBatchResult_INVALID_ARGUMENT ExperienceBatchResult_Status = 3
+	// the batch chunk containing experience failed, nothing in the chunk is changed
+	ExperienceBatchResult_ERROR ExperienceBatchResult_Status = 4
+	// experience overlaps stored experiences and is rejected by the overlap policy
+	ExperienceBatchResult_OVERLAP ExperienceBatchResult_Status = 5
+)
+
+// Enum value maps for ExperienceBatchResult_Status.
+var (
+	ExperienceBatchResult_Status_name = map[int32]string{
+		0: "OK",
+		1: "NOT_FOUND",
+		2: "VERSION_MISMATCH",
+		3: "INVALID_ARGUMENT",
+		4: "ERROR",
+		5: "OVERLAP",
+	}
+	ExperienceBatchResult_Status_value = map[string]int32{
+		"OK":               0,
+		"NOT_FOUND":        1,
+		"VERSION_MISMATCH": 2,
+		"INVALID_ARGUMENT": 3,
+		"ERROR":            4,
+		"OVERLAP":          5,
+	}
+)
+
+func (x ExperienceBatchResult_Status) Enum() *ExperienceBatchResult_Status {
+	p := new(ExperienceBatchResult_Status)
+	*p = x
+	return p
+}
+
+func (x ExperienceBatchResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExperienceBatchResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[3].Descriptor()
+}
+
+func (ExperienceBatchResult_Status) Type() protoreflect.EnumType {
+	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[3]
+}
+
+func (x ExperienceBatchResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExperienceBatchResult_Status.Descriptor instead.
+func (ExperienceBatchResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{50, 0}
+}
+
 type ExperienceAPIEvent_EventType int32
 
 const (
@@ -59,11 +285,11 @@ func (x ExperienceAPIEvent_EventType) String() string {
 }
 
 func (ExperienceAPIEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[0].Descriptor()
+	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[4].Descriptor()
 }
 
 func (ExperienceAPIEvent_EventType) Type() protoreflect.EnumType {
-	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[0]
+	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[4]
 }
 
 func (x ExperienceAPIEvent_EventType) Number() protoreflect.EnumNumber {
@@ -72,7 +298,54 @@ func (x ExperienceAPIEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExperienceAPIEvent_EventType.Descriptor instead.
 func (ExperienceAPIEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{13, 0}
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{56, 0}
+}
+
+// kind of entity the id belongs to
+type ExperienceAPIEvent_Entity int32
+
+const (
+	ExperienceAPIEvent_EXPERIENCE      ExperienceAPIEvent_Entity = 0
+	ExperienceAPIEvent_EXPERIENCE_TYPE ExperienceAPIEvent_Entity = 1
+)
+
+// Enum value maps for ExperienceAPIEvent_Entity.
+var (
+	ExperienceAPIEvent_Entity_name = map[int32]string{
+		0: "EXPERIENCE",
+		1: "EXPERIENCE_TYPE",
+	}
+	ExperienceAPIEvent_Entity_value = map[string]int32{
+		"EXPERIENCE":      0,
+		"EXPERIENCE_TYPE": 1,
+	}
+)
+
+func (x ExperienceAPIEvent_Entity) Enum() *ExperienceAPIEvent_Entity {
+	p := new(ExperienceAPIEvent_Entity)
+	*p = x
+	return p
+}
+
+func (x ExperienceAPIEvent_Entity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExperienceAPIEvent_Entity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[5].Descriptor()
+}
+
+func (ExperienceAPIEvent_Entity) Type() protoreflect.EnumType {
+	return &file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes[5]
+}
+
+func (x ExperienceAPIEvent_Entity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExperienceAPIEvent_Entity.Descriptor instead.
+func (ExperienceAPIEvent_Entity) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{56, 1}
 }
 
 // ListExperienceV1Request defines a size and offset of experience list
@@ -81,8 +354,16 @@ type ListExperienceV1Request struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   uint64            `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset  uint64            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter  *ExperienceFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy *ExperienceOrder  `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// next_page_token of the previous response. Offset is not allowed with a page token
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// do not count total_count for expensive queries
+	SkipTotalCount bool `protobuf:"varint,6,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`
+	// sets experience type_name
+	IncludeTypeName bool `protobuf:"varint,7,opt,name=include_type_name,json=includeTypeName,proto3" json:"include_type_name,omitempty"`
 }
 
 func (x *ListExperienceV1Request) Reset() {
@@ -131,17 +412,53 @@ func (x *ListExperienceV1Request) GetOffset() uint64 {
 	return 0
 }
 
-// Contains an experience list
-type ListExperienceV1Response struct {
+func (x *ListExperienceV1Request) GetFilter() *ExperienceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListExperienceV1Request) GetOrderBy() *ExperienceOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *ListExperienceV1Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListExperienceV1Request) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+func (x *ListExperienceV1Request) GetIncludeTypeName() bool {
+	if x != nil {
+		return x.IncludeTypeName
+	}
+	return false
+}
+
+// Defines experiences to stream
+type ListExperienceV1StreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Experiences []*Experience `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Filter  *ExperienceFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy *ExperienceOrder  `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
-func (x *ListExperienceV1Response) Reset() {
-	*x = ListExperienceV1Response{}
+func (x *ListExperienceV1StreamRequest) Reset() {
+	*x = ListExperienceV1StreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,13 +466,13 @@ func (x *ListExperienceV1Response) Reset() {
 	}
 }
 
-func (x *ListExperienceV1Response) String() string {
+func (x *ListExperienceV1StreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExperienceV1Response) ProtoMessage() {}
+func (*ListExperienceV1StreamRequest) ProtoMessage() {}
 
-func (x *ListExperienceV1Response) ProtoReflect() protoreflect.Message {
+func (x *ListExperienceV1StreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,33 +484,36 @@ func (x *ListExperienceV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExperienceV1Response.ProtoReflect.Descriptor instead.
-func (*ListExperienceV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListExperienceV1StreamRequest.ProtoReflect.Descriptor instead.
+func (*ListExperienceV1StreamRequest) Descriptor() ([]byte, []int) {
 	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListExperienceV1Response) GetExperiences() []*Experience {
+func (x *ListExperienceV1StreamRequest) GetFilter() *ExperienceFilter {
 	if x != nil {
-		return x.Experiences
+		return x.Filter
 	}
 	return nil
 }
 
-// Contains new experience data
-type CreateExperienceV1Request struct {
+func (x *ListExperienceV1StreamRequest) GetOrderBy() *ExperienceOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+// Contains a streamed experience
+type ListExperienceV1StreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId uint64               `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type   uint64               `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	From   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	To     *timestamp.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Level  uint64               `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
+	Experience *Experience `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
 }
 
-func (x *CreateExperienceV1Request) Reset() {
-	*x = CreateExperienceV1Request{}
+func (x *ListExperienceV1StreamResponse) Reset() {
+	*x = ListExperienceV1StreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -201,13 +521,13 @@ func (x *CreateExperienceV1Request) Reset() {
 	}
 }
 
-func (x *CreateExperienceV1Request) String() string {
+func (x *ListExperienceV1StreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExperienceV1Request) ProtoMessage() {}
+func (*ListExperienceV1StreamResponse) ProtoMessage() {}
 
-func (x *CreateExperienceV1Request) ProtoReflect() protoreflect.Message {
+func (x *ListExperienceV1StreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,57 +539,39 @@ func (x *CreateExperienceV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExperienceV1Request.ProtoReflect.Descriptor instead.
-func (*CreateExperienceV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListExperienceV1StreamResponse.ProtoReflect.Descriptor instead.
+func (*ListExperienceV1StreamResponse) Descriptor() ([]byte, []int) {
 	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateExperienceV1Request) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *CreateExperienceV1Request) GetType() uint64 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-func (x *CreateExperienceV1Request) GetFrom() *timestamp.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *CreateExperienceV1Request) GetTo() *timestamp.Timestamp {
+func (x *ListExperienceV1StreamResponse) GetExperience() *Experience {
 	if x != nil {
-		return x.To
+		return x.Experience
 	}
 	return nil
 }
 
-func (x *CreateExperienceV1Request) GetLevel() uint64 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
-
-// Contains created Experience id.
-type CreateExperienceV1Response struct {
+// Experience list filter. Empty fields are not applied
+type ExperienceFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId   uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Types    []uint64 `protobuf:"varint,2,rep,packed,name=types,proto3" json:"types,omitempty"`
+	MinLevel uint64   `protobuf:"varint,3,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	MaxLevel uint64   `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	// experiences intersecting [from, to] window
+	From *timestamp.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// removed experiences are not listed by default
+	IncludeDeleted bool `protobuf:"varint,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// lists only experiences without to date
+	OngoingOnly bool `protobuf:"varint,8,opt,name=ongoing_only,json=ongoingOnly,proto3" json:"ongoing_only,omitempty"`
 }
 
-func (x *CreateExperienceV1Response) Reset() {
-	*x = CreateExperienceV1Response{}
+func (x *ExperienceFilter) Reset() {
+	*x = ExperienceFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -277,13 +579,13 @@ func (x *CreateExperienceV1Response) Reset() {
 	}
 }
 
-func (x *CreateExperienceV1Response) String() string {
+func (x *ExperienceFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExperienceV1Response) ProtoMessage() {}
+func (*ExperienceFilter) ProtoMessage() {}
 
-func (x *CreateExperienceV1Response) ProtoReflect() protoreflect.Message {
+func (x *ExperienceFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -295,92 +597,94 @@ func (x *CreateExperienceV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExperienceV1Response.ProtoReflect.Descriptor instead.
-func (*CreateExperienceV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExperienceFilter.ProtoReflect.Descriptor instead.
+func (*ExperienceFilter) Descriptor() ([]byte, []int) {
 	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateExperienceV1Response) GetId() uint64 {
+func (x *ExperienceFilter) GetUserId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-// Experience id to delete
-type RemoveExperienceV1Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ExperienceFilter) GetTypes() []uint64 {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-func (x *RemoveExperienceV1Request) Reset() {
-	*x = RemoveExperienceV1Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExperienceFilter) GetMinLevel() uint64 {
+	if x != nil {
+		return x.MinLevel
 	}
+	return 0
 }
 
-func (x *RemoveExperienceV1Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExperienceFilter) GetMaxLevel() uint64 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
 }
 
-func (*RemoveExperienceV1Request) ProtoMessage() {}
-
-func (x *RemoveExperienceV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExperienceFilter) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RemoveExperienceV1Request.ProtoReflect.Descriptor instead.
-func (*RemoveExperienceV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{4}
+func (x *ExperienceFilter) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
 }
 
-func (x *RemoveExperienceV1Request) GetId() uint64 {
+func (x *ExperienceFilter) GetIncludeDeleted() bool {
 	if x != nil {
-		return x.Id
+		return x.IncludeDeleted
 	}
-	return 0
+	return false
 }
 
-// Remove result
-type RemoveExperienceV1Response struct {
+func (x *ExperienceFilter) GetOngoingOnly() bool {
+	if x != nil {
+		return x.OngoingOnly
+	}
+	return false
+}
+
+// Experience list sort order. Sorts by id ascending by default
+type ExperienceOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Removed bool `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	Field ExperienceOrder_Field `protobuf:"varint,1,opt,name=field,proto3,enum=ocp.experience.api.ExperienceOrder_Field" json:"field,omitempty"`
+	Desc  bool                  `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
 }
 
-func (x *RemoveExperienceV1Response) Reset() {
-	*x = RemoveExperienceV1Response{}
+func (x *ExperienceOrder) Reset() {
+	*x = ExperienceOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[5]
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveExperienceV1Response) String() string {
+func (x *ExperienceOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveExperienceV1Response) ProtoMessage() {}
+func (*ExperienceOrder) ProtoMessage() {}
 
-func (x *RemoveExperienceV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[5]
+func (x *ExperienceOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,44 +695,56 @@ func (x *RemoveExperienceV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveExperienceV1Response.ProtoReflect.Descriptor instead.
-func (*RemoveExperienceV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExperienceOrder.ProtoReflect.Descriptor instead.
+func (*ExperienceOrder) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RemoveExperienceV1Response) GetRemoved() bool {
+func (x *ExperienceOrder) GetField() ExperienceOrder_Field {
 	if x != nil {
-		return x.Removed
+		return x.Field
+	}
+	return ExperienceOrder_ID
+}
+
+func (x *ExperienceOrder) GetDesc() bool {
+	if x != nil {
+		return x.Desc
 	}
 	return false
 }
 
-// Experience id to get response
-type DescribeExperienceV1Request struct {
+// Contains an experience list
+type ListExperienceV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Experiences []*Experience `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// token to request the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// number of experiences matching filter, not set if skip_total_count is requested
+	TotalCount uint64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasMore    bool   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
-func (x *DescribeExperienceV1Request) Reset() {
-	*x = DescribeExperienceV1Request{}
+func (x *ListExperienceV1Response) Reset() {
+	*x = ListExperienceV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[6]
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DescribeExperienceV1Request) String() string {
+func (x *ListExperienceV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeExperienceV1Request) ProtoMessage() {}
+func (*ListExperienceV1Response) ProtoMessage() {}
 
-func (x *DescribeExperienceV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[6]
+func (x *ListExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,97 +755,72 @@ func (x *DescribeExperienceV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeExperienceV1Request.ProtoReflect.Descriptor instead.
-func (*DescribeExperienceV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*ListExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DescribeExperienceV1Request) GetId() uint64 {
+func (x *ListExperienceV1Response) GetExperiences() []*Experience {
 	if x != nil {
-		return x.Id
+		return x.Experiences
 	}
-	return 0
-}
-
-// Contains experience info
-type DescribeExperienceV1Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Experience *Experience `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+	return nil
 }
 
-func (x *DescribeExperienceV1Response) Reset() {
-	*x = DescribeExperienceV1Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListExperienceV1Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
+	return ""
 }
 
-func (x *DescribeExperienceV1Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DescribeExperienceV1Response) ProtoMessage() {}
-
-func (x *DescribeExperienceV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListExperienceV1Response) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DescribeExperienceV1Response.ProtoReflect.Descriptor instead.
-func (*DescribeExperienceV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{7}
+	return 0
 }
 
-func (x *DescribeExperienceV1Response) GetExperience() *Experience {
+func (x *ListExperienceV1Response) GetHasMore() bool {
 	if x != nil {
-		return x.Experience
+		return x.HasMore
 	}
-	return nil
+	return false
 }
 
-// main entity
-type Experience struct {
+// Contains new experience data
+type CreateExperienceV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId uint64               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type   uint64               `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	From   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
-	To     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
-	Level  uint64               `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	UserId uint64               `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type   uint64               `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	From   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// not set for ongoing experience
+	To    *timestamp.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Level uint64               `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
+	// replayed requests with the key return the original response, idempotency-key header may be used instead
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *Experience) Reset() {
-	*x = Experience{}
+func (x *CreateExperienceV1Request) Reset() {
+	*x = CreateExperienceV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[8]
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Experience) String() string {
+func (x *CreateExperienceV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Experience) ProtoMessage() {}
+func (*CreateExperienceV1Request) ProtoMessage() {}
 
-func (x *Experience) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[8]
+func (x *CreateExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,79 +831,81 @@ func (x *Experience) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Experience.ProtoReflect.Descriptor instead.
-func (*Experience) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Experience) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use CreateExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*CreateExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Experience) GetUserId() uint64 {
+func (x *CreateExperienceV1Request) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *Experience) GetType() uint64 {
+func (x *CreateExperienceV1Request) GetType() uint64 {
 	if x != nil {
 		return x.Type
 	}
 	return 0
 }
 
-func (x *Experience) GetFrom() *timestamp.Timestamp {
+func (x *CreateExperienceV1Request) GetFrom() *timestamp.Timestamp {
 	if x != nil {
 		return x.From
 	}
 	return nil
 }
 
-func (x *Experience) GetTo() *timestamp.Timestamp {
+func (x *CreateExperienceV1Request) GetTo() *timestamp.Timestamp {
 	if x != nil {
 		return x.To
 	}
 	return nil
 }
 
-func (x *Experience) GetLevel() uint64 {
+func (x *CreateExperienceV1Request) GetLevel() uint64 {
 	if x != nil {
 		return x.Level
 	}
 	return 0
 }
 
-// Contains a batch of new experiences
-type MultiCreateExperienceV1Request struct {
+func (x *CreateExperienceV1Request) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// Contains created Experience id. If the experience is merged with stored experiences, contains ids of them,
+// the first one is kept with returned id and the others are removed
+type CreateExperienceV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Experiences []*CreateExperienceV1Request `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Id        uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MergedIds []uint64 `protobuf:"varint,2,rep,packed,name=merged_ids,json=mergedIds,proto3" json:"merged_ids,omitempty"`
 }
 
-func (x *MultiCreateExperienceV1Request) Reset() {
-	*x = MultiCreateExperienceV1Request{}
+func (x *CreateExperienceV1Response) Reset() {
+	*x = CreateExperienceV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[9]
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiCreateExperienceV1Request) String() string {
+func (x *CreateExperienceV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiCreateExperienceV1Request) ProtoMessage() {}
+func (*CreateExperienceV1Response) ProtoMessage() {}
 
-func (x *MultiCreateExperienceV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[9]
+func (x *CreateExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,44 +916,53 @@ func (x *MultiCreateExperienceV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiCreateExperienceV1Request.ProtoReflect.Descriptor instead.
-func (*MultiCreateExperienceV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*CreateExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MultiCreateExperienceV1Request) GetExperiences() []*CreateExperienceV1Request {
+func (x *CreateExperienceV1Response) GetId() uint64 {
 	if x != nil {
-		return x.Experiences
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateExperienceV1Response) GetMergedIds() []uint64 {
+	if x != nil {
+		return x.MergedIds
 	}
 	return nil
 }
 
-// Api returns created experience ids
-type MultiCreateExperienceV1Response struct {
+// Experience id to delete
+type RemoveExperienceV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// removes experience only if it has the version. Maps to If-Match header
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
-func (x *MultiCreateExperienceV1Response) Reset() {
-	*x = MultiCreateExperienceV1Response{}
+func (x *RemoveExperienceV1Request) Reset() {
+	*x = RemoveExperienceV1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[10]
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiCreateExperienceV1Response) String() string {
+func (x *RemoveExperienceV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiCreateExperienceV1Response) ProtoMessage() {}
+func (*RemoveExperienceV1Request) ProtoMessage() {}
 
-func (x *MultiCreateExperienceV1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[10]
+func (x *RemoveExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,49 +973,51 @@ func (x *MultiCreateExperienceV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiCreateExperienceV1Response.ProtoReflect.Descriptor instead.
-func (*MultiCreateExperienceV1Response) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RemoveExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*RemoveExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MultiCreateExperienceV1Response) GetIds() []uint64 {
+func (x *RemoveExperienceV1Request) GetId() uint64 {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// Updates experience info
-type UpdateExperienceV1Request struct {
+func (x *RemoveExperienceV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// Remove result
+type RemoveExperienceV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId uint64               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type   uint64               `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	From   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
-	To     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
-	Level  uint64               `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	Removed bool `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
-func (x *UpdateExperienceV1Request) Reset() {
-	*x = UpdateExperienceV1Request{}
+func (x *RemoveExperienceV1Response) Reset() {
+	*x = RemoveExperienceV1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[11]
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateExperienceV1Request) String() string {
+func (x *RemoveExperienceV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExperienceV1Request) ProtoMessage() {}
+func (*RemoveExperienceV1Response) ProtoMessage() {}
 
-func (x *UpdateExperienceV1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[11]
+func (x *RemoveExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,62 +1028,127 @@ func (x *UpdateExperienceV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExperienceV1Request.ProtoReflect.Descriptor instead.
-func (*UpdateExperienceV1Request) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*RemoveExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateExperienceV1Request) GetId() uint64 {
+func (x *RemoveExperienceV1Response) GetRemoved() bool {
 	if x != nil {
-		return x.Id
+		return x.Removed
 	}
-	return 0
+	return false
 }
 
-func (x *UpdateExperienceV1Request) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
+// Removed experience id to restore
+type RestoreExperienceV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreExperienceV1Request) Reset() {
+	*x = RestoreExperienceV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UpdateExperienceV1Request) GetType() uint64 {
+func (x *RestoreExperienceV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExperienceV1Request) ProtoMessage() {}
+
+func (x *RestoreExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*RestoreExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreExperienceV1Request) GetId() uint64 {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateExperienceV1Request) GetFrom() *timestamp.Timestamp {
-	if x != nil {
-		return x.From
+// Restore result
+type RestoreExperienceV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Restored bool `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+}
+
+func (x *RestoreExperienceV1Response) Reset() {
+	*x = RestoreExperienceV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UpdateExperienceV1Request) GetTo() *timestamp.Timestamp {
-	if x != nil {
-		return x.To
+func (x *RestoreExperienceV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExperienceV1Response) ProtoMessage() {}
+
+func (x *RestoreExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateExperienceV1Request) GetLevel() uint64 {
+// Deprecated: Use RestoreExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*RestoreExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreExperienceV1Response) GetRestored() bool {
 	if x != nil {
-		return x.Level
+		return x.Restored
 	}
-	return 0
+	return false
 }
 
-// Update experience result
-type UpdateExperienceV1Response struct {
+// Experience id to get response
+type DescribeExperienceV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sets experience type_name
+	IncludeTypeName bool `protobuf:"varint,2,opt,name=include_type_name,json=includeTypeName,proto3" json:"include_type_name,omitempty"`
 }
 
-func (x *UpdateExperienceV1Response) Reset() {
-	*x = UpdateExperienceV1Response{}
+func (x *DescribeExperienceV1Request) Reset() {
+	*x = DescribeExperienceV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -787,13 +1156,13 @@ func (x *UpdateExperienceV1Response) Reset() {
 	}
 }
 
-func (x *UpdateExperienceV1Response) String() string {
+func (x *DescribeExperienceV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExperienceV1Response) ProtoMessage() {}
+func (*DescribeExperienceV1Request) ProtoMessage() {}
 
-func (x *UpdateExperienceV1Response) ProtoReflect() protoreflect.Message {
+func (x *DescribeExperienceV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,25 +1174,36 @@ func (x *UpdateExperienceV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExperienceV1Response.ProtoReflect.Descriptor instead.
-func (*UpdateExperienceV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*DescribeExperienceV1Request) Descriptor() ([]byte, []int) {
 	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{12}
 }
 
-// The below below related to API events that would be sent via Kafka
-type ExperienceAPIEvent struct {
+func (x *DescribeExperienceV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DescribeExperienceV1Request) GetIncludeTypeName() bool {
+	if x != nil {
+		return x.IncludeTypeName
+	}
+	return false
+}
+
+// Contains experience info
+type DescribeExperienceV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Event     ExperienceAPIEvent_EventType `protobuf:"varint,2,opt,name=event,proto3,enum=ocp.experience.api.ExperienceAPIEvent_EventType" json:"event,omitempty"`
-	Error     string                       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	TraceSpan map[string]string            `protobuf:"bytes,4,rep,name=trace_span,json=traceSpan,proto3" json:"trace_span,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Experience *Experience `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
 }
 
-func (x *ExperienceAPIEvent) Reset() {
-	*x = ExperienceAPIEvent{}
+func (x *DescribeExperienceV1Response) Reset() {
+	*x = DescribeExperienceV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,313 +1211,3917 @@ func (x *ExperienceAPIEvent) Reset() {
 	}
 }
 
-func (x *ExperienceAPIEvent) String() string {
+func (x *DescribeExperienceV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperienceAPIEvent) ProtoMessage() {}
+func (*DescribeExperienceV1Response) ProtoMessage() {}
 
-func (x *ExperienceAPIEvent) ProtoReflect() protoreflect.Message {
+func (x *DescribeExperienceV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ExperienceAPIEvent.ProtoReflect.Descriptor instead.
-func (*ExperienceAPIEvent) Descriptor() ([]byte, []int) {
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ExperienceAPIEvent) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ExperienceAPIEvent) GetEvent() ExperienceAPIEvent_EventType {
-	if x != nil {
-		return x.Event
-	}
-	return ExperienceAPIEvent_CREATE
-}
-
-func (x *ExperienceAPIEvent) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *ExperienceAPIEvent) GetTraceSpan() map[string]string {
-	if x != nil {
-		return x.TraceSpan
-	}
-	return nil
-}
-
-var File_api_ocp_experience_api_ocp_experience_api_proto protoreflect.FileDescriptor
-
-var file_api_ocp_experience_api_ocp_experience_api_proto_rawDesc = []byte{
-	0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x12, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05, 0x18, 0x90, 0x4e, 0x20, 0x00, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x5c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a,
-	0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x1b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5e, 0x0a,
-	0x1c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xbb, 0x01,
-	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x71, 0x0a, 0x1e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a,
-	0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x33,
-	0x0a, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03,
-	0x69, 0x64, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xd1, 0x02, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41,
-	0x50, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x50, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73,
-	0x70, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
-	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x50, 0x49, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x09, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x03, 0x32, 0x98, 0x07, 0x0a, 0x10, 0x4f, 0x63, 0x70, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x70, 0x69, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2b,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x63,
-	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56,
-	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2f, 0x2e, 0x6f, 0x63,
-	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f,
-	0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8f, 0x01, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
-	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x91,
-	0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76,
-	0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x32,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22,
-	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x94, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12,
-	0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x42,
-	0x50, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a,
-	0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
-	0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x63, 0x70, 0x2d,
-	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f,
-	0x63, 0x70, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_api_ocp_experience_api_ocp_experience_api_proto_rawDescOnce sync.Once
-	file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData = file_api_ocp_experience_api_ocp_experience_api_proto_rawDesc
-)
-
-func file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP() []byte {
-	file_api_ocp_experience_api_ocp_experience_api_proto_rawDescOnce.Do(func() {
-		file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData)
-	})
-	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData
-}
-
-var file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_api_ocp_experience_api_ocp_experience_api_proto_goTypes = []interface{}{
-	(ExperienceAPIEvent_EventType)(0),       // 0: ocp.experience.api.ExperienceAPIEvent.EventType
-	(*ListExperienceV1Request)(nil),         // 1: ocp.experience.api.ListExperienceV1Request
-	(*ListExperienceV1Response)(nil),        // 2: ocp.experience.api.ListExperienceV1Response
-	(*CreateExperienceV1Request)(nil),       // 3: ocp.experience.api.CreateExperienceV1Request
-	(*CreateExperienceV1Response)(nil),      // 4: ocp.experience.api.CreateExperienceV1Response
-	(*RemoveExperienceV1Request)(nil),       // 5: ocp.experience.api.RemoveExperienceV1Request
-	(*RemoveExperienceV1Response)(nil),      // 6: ocp.experience.api.RemoveExperienceV1Response
-	(*DescribeExperienceV1Request)(nil),     // 7: ocp.experience.api.DescribeExperienceV1Request
-	(*DescribeExperienceV1Response)(nil),    // 8: ocp.experience.api.DescribeExperienceV1Response
-	(*Experience)(nil),                      // 9: ocp.experience.api.Experience
-	(*MultiCreateExperienceV1Request)(nil),  // 10: ocp.experience.api.MultiCreateExperienceV1Request
-	(*MultiCreateExperienceV1Response)(nil), // 11: ocp.experience.api.MultiCreateExperienceV1Response
-	(*UpdateExperienceV1Request)(nil),       // 12: ocp.experience.api.UpdateExperienceV1Request
-	(*UpdateExperienceV1Response)(nil),      // 13: ocp.experience.api.UpdateExperienceV1Response
-	(*ExperienceAPIEvent)(nil),              // 14: ocp.experience.api.ExperienceAPIEvent
-	nil,                                     // 15: ocp.experience.api.ExperienceAPIEvent.TraceSpanEntry
-	(*timestamp.Timestamp)(nil),             // 16: google.protobuf.Timestamp
-}
-var file_api_ocp_experience_api_ocp_experience_api_proto_depIdxs = []int32{
-	9,  // 0: ocp.experience.api.ListExperienceV1Response.experiences:type_name -> ocp.experience.api.Experience
-	16, // 1: ocp.experience.api.CreateExperienceV1Request.from:type_name -> google.protobuf.Timestamp
-	16, // 2: ocp.experience.api.CreateExperienceV1Request.to:type_name -> google.protobuf.Timestamp
-	9,  // 3: ocp.experience.api.DescribeExperienceV1Response.experience:type_name -> ocp.experience.api.Experience
-	16, // 4: ocp.experience.api.Experience.from:type_name -> google.protobuf.Timestamp
-	16, // 5: ocp.experience.api.Experience.to:type_name -> google.protobuf.Timestamp
-	3,  // 6: ocp.experience.api.MultiCreateExperienceV1Request.experiences:type_name -> ocp.experience.api.CreateExperienceV1Request
-	16, // 7: ocp.experience.api.UpdateExperienceV1Request.from:type_name -> google.protobuf.Timestamp
-	16, // 8: ocp.experience.api.UpdateExperienceV1Request.to:type_name -> google.protobuf.Timestamp
-	0,  // 9: ocp.experience.api.ExperienceAPIEvent.event:type_name -> ocp.experience.api.ExperienceAPIEvent.EventType
-	15, // 10: ocp.experience.api.ExperienceAPIEvent.trace_span:type_name -> ocp.experience.api.ExperienceAPIEvent.TraceSpanEntry
-	1,  // 11: ocp.experience.api.OcpExperienceApi.ListExperienceV1:input_type -> ocp.experience.api.ListExperienceV1Request
-	7,  // 12: ocp.experience.api.OcpExperienceApi.DescribeExperienceV1:input_type -> ocp.experience.api.DescribeExperienceV1Request
-	3,  // 13: ocp.experience.api.OcpExperienceApi.CreateExperienceV1:input_type -> ocp.experience.api.CreateExperienceV1Request
-	5,  // 14: ocp.experience.api.OcpExperienceApi.RemoveExperienceV1:input_type -> ocp.experience.api.RemoveExperienceV1Request
-	10, // 15: ocp.experience.api.OcpExperienceApi.MultiCreateExperienceV1:input_type -> ocp.experience.api.MultiCreateExperienceV1Request
-	12, // 16: ocp.experience.api.OcpExperienceApi.UpdateExperienceV1:input_type -> ocp.experience.api.UpdateExperienceV1Request
-	2,  // 17: ocp.experience.api.OcpExperienceApi.ListExperienceV1:output_type -> ocp.experience.api.ListExperienceV1Response
-	8,  // 18: ocp.experience.api.OcpExperienceApi.DescribeExperienceV1:output_type -> ocp.experience.api.DescribeExperienceV1Response
-	4,  // 19: ocp.experience.api.OcpExperienceApi.CreateExperienceV1:output_type -> ocp.experience.api.CreateExperienceV1Response
-	6,  // 20: ocp.experience.api.OcpExperienceApi.RemoveExperienceV1:output_type -> ocp.experience.api.RemoveExperienceV1Response
-	11, // 21: ocp.experience.api.OcpExperienceApi.MultiCreateExperienceV1:output_type -> ocp.experience.api.MultiCreateExperienceV1Response
-	13, // 22: ocp.experience.api.OcpExperienceApi.UpdateExperienceV1:output_type -> ocp.experience.api.UpdateExperienceV1Response
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
-}
-
-func init() { file_api_ocp_experience_api_ocp_experience_api_proto_init() }
-func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
-	if File_api_ocp_experience_api_ocp_experience_api_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExperienceV1Request); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*DescribeExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DescribeExperienceV1Response) GetExperience() *Experience {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+// main entity
+type Experience struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId uint64               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type   uint64               `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	From   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// not set if experience is ongoing
+	To    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Level uint64               `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	// bumped on every update. Maps to ETag header
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// removal time, not set if experience is not removed
+	DeletedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// name of type from the type catalog, set on request
+	TypeName string `protobuf:"bytes,9,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+}
+
+func (x *Experience) Reset() {
+	*x = Experience{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Experience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experience) ProtoMessage() {}
+
+func (x *Experience) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experience.ProtoReflect.Descriptor instead.
+func (*Experience) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Experience) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Experience) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Experience) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Experience) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Experience) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Experience) GetLevel() uint64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Experience) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Experience) GetDeletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Experience) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+// Experience type of the type catalog
+type ExperienceType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ExperienceType) Reset() {
+	*x = ExperienceType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceType) ProtoMessage() {}
+
+func (x *ExperienceType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceType.ProtoReflect.Descriptor instead.
+func (*ExperienceType) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExperienceType) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExperienceType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Defines a size and offset of experience type list
+type ListExperienceTypesV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListExperienceTypesV1Request) Reset() {
+	*x = ListExperienceTypesV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExperienceTypesV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceTypesV1Request) ProtoMessage() {}
+
+func (x *ListExperienceTypesV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceTypesV1Request.ProtoReflect.Descriptor instead.
+func (*ListExperienceTypesV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListExperienceTypesV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListExperienceTypesV1Request) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Contains experience types sorted by id
+type ListExperienceTypesV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types []*ExperienceType `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *ListExperienceTypesV1Response) Reset() {
+	*x = ListExperienceTypesV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExperienceTypesV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceTypesV1Response) ProtoMessage() {}
+
+func (x *ListExperienceTypesV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceTypesV1Response.ProtoReflect.Descriptor instead.
+func (*ListExperienceTypesV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListExperienceTypesV1Response) GetTypes() []*ExperienceType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// Experience type id to get
+type DescribeExperienceTypeV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DescribeExperienceTypeV1Request) Reset() {
+	*x = DescribeExperienceTypeV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeExperienceTypeV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeExperienceTypeV1Request) ProtoMessage() {}
+
+func (x *DescribeExperienceTypeV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeExperienceTypeV1Request.ProtoReflect.Descriptor instead.
+func (*DescribeExperienceTypeV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DescribeExperienceTypeV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Contains experience type
+type DescribeExperienceTypeV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type *ExperienceType `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *DescribeExperienceTypeV1Response) Reset() {
+	*x = DescribeExperienceTypeV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeExperienceTypeV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeExperienceTypeV1Response) ProtoMessage() {}
+
+func (x *DescribeExperienceTypeV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeExperienceTypeV1Response.ProtoReflect.Descriptor instead.
+func (*DescribeExperienceTypeV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DescribeExperienceTypeV1Response) GetType() *ExperienceType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+// New experience type, name is unique
+type CreateExperienceTypeV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateExperienceTypeV1Request) Reset() {
+	*x = CreateExperienceTypeV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExperienceTypeV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperienceTypeV1Request) ProtoMessage() {}
+
+func (x *CreateExperienceTypeV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperienceTypeV1Request.ProtoReflect.Descriptor instead.
+func (*CreateExperienceTypeV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateExperienceTypeV1Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Api returns created experience type id
+type CreateExperienceTypeV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateExperienceTypeV1Response) Reset() {
+	*x = CreateExperienceTypeV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExperienceTypeV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperienceTypeV1Response) ProtoMessage() {}
+
+func (x *CreateExperienceTypeV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperienceTypeV1Response.ProtoReflect.Descriptor instead.
+func (*CreateExperienceTypeV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateExperienceTypeV1Response) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Renames experience type, name is unique
+type UpdateExperienceTypeV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UpdateExperienceTypeV1Request) Reset() {
+	*x = UpdateExperienceTypeV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExperienceTypeV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperienceTypeV1Request) ProtoMessage() {}
+
+func (x *UpdateExperienceTypeV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperienceTypeV1Request.ProtoReflect.Descriptor instead.
+func (*UpdateExperienceTypeV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateExperienceTypeV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateExperienceTypeV1Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Update experience type result
+type UpdateExperienceTypeV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateExperienceTypeV1Response) Reset() {
+	*x = UpdateExperienceTypeV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExperienceTypeV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperienceTypeV1Response) ProtoMessage() {}
+
+func (x *UpdateExperienceTypeV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperienceTypeV1Response.ProtoReflect.Descriptor instead.
+func (*UpdateExperienceTypeV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{23}
+}
+
+// Experience type id to remove
+type RemoveExperienceTypeV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveExperienceTypeV1Request) Reset() {
+	*x = RemoveExperienceTypeV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveExperienceTypeV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveExperienceTypeV1Request) ProtoMessage() {}
+
+func (x *RemoveExperienceTypeV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveExperienceTypeV1Request.ProtoReflect.Descriptor instead.
+func (*RemoveExperienceTypeV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveExperienceTypeV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Remove experience type result
+type RemoveExperienceTypeV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed bool `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *RemoveExperienceTypeV1Response) Reset() {
+	*x = RemoveExperienceTypeV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveExperienceTypeV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveExperienceTypeV1Response) ProtoMessage() {}
+
+func (x *RemoveExperienceTypeV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveExperienceTypeV1Response.ProtoReflect.Descriptor instead.
+func (*RemoveExperienceTypeV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveExperienceTypeV1Response) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// Level of experience type ladder
+type ExperienceLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level       uint64               `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MinDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+}
+
+func (x *ExperienceLevel) Reset() {
+	*x = ExperienceLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceLevel) ProtoMessage() {}
+
+func (x *ExperienceLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceLevel.ProtoReflect.Descriptor instead.
+func (*ExperienceLevel) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExperienceLevel) GetLevel() uint64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ExperienceLevel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExperienceLevel) GetMinDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+// Experience type id to get the level ladder of
+type ListExperienceLevelsV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeId uint64 `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+}
+
+func (x *ListExperienceLevelsV1Request) Reset() {
+	*x = ListExperienceLevelsV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExperienceLevelsV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceLevelsV1Request) ProtoMessage() {}
+
+func (x *ListExperienceLevelsV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceLevelsV1Request.ProtoReflect.Descriptor instead.
+func (*ListExperienceLevelsV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListExperienceLevelsV1Request) GetTypeId() uint64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+// Contains the level ladder sorted by level
+type ListExperienceLevelsV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Levels []*ExperienceLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+}
+
+func (x *ListExperienceLevelsV1Response) Reset() {
+	*x = ListExperienceLevelsV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExperienceLevelsV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceLevelsV1Response) ProtoMessage() {}
+
+func (x *ListExperienceLevelsV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceLevelsV1Response.ProtoReflect.Descriptor instead.
+func (*ListExperienceLevelsV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListExperienceLevelsV1Response) GetLevels() []*ExperienceLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+// New level ladder of experience type, levels and names are unique
+type SetExperienceLevelsV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeId uint64             `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	Levels []*ExperienceLevel `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
+}
+
+func (x *SetExperienceLevelsV1Request) Reset() {
+	*x = SetExperienceLevelsV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExperienceLevelsV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExperienceLevelsV1Request) ProtoMessage() {}
+
+func (x *SetExperienceLevelsV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExperienceLevelsV1Request.ProtoReflect.Descriptor instead.
+func (*SetExperienceLevelsV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetExperienceLevelsV1Request) GetTypeId() uint64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *SetExperienceLevelsV1Request) GetLevels() []*ExperienceLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+// Set level ladder result
+type SetExperienceLevelsV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetExperienceLevelsV1Response) Reset() {
+	*x = SetExperienceLevelsV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExperienceLevelsV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExperienceLevelsV1Response) ProtoMessage() {}
+
+func (x *SetExperienceLevelsV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExperienceLevelsV1Response.ProtoReflect.Descriptor instead.
+func (*SetExperienceLevelsV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{30}
+}
+
+// Experience id to get changes of, defines a size and offset of changes list
+type ListExperienceHistoryV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListExperienceHistoryV1Request) Reset() {
+	*x = ListExperienceHistoryV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExperienceHistoryV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceHistoryV1Request) ProtoMessage() {}
+
+func (x *ListExperienceHistoryV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceHistoryV1Request.ProtoReflect.Descriptor instead.
+func (*ListExperienceHistoryV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListExperienceHistoryV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListExperienceHistoryV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListExperienceHistoryV1Request) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Contains experience changes, the oldest change first
+type ListExperienceHistoryV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*ExperienceHistoryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ListExperienceHistoryV1Response) Reset() {
+	*x = ListExperienceHistoryV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExperienceHistoryV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExperienceHistoryV1Response) ProtoMessage() {}
+
+func (x *ListExperienceHistoryV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExperienceHistoryV1Response.ProtoReflect.Descriptor instead.
+func (*ListExperienceHistoryV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListExperienceHistoryV1Response) GetRecords() []*ExperienceHistoryRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// User id to list experiences of and a page size
+type ListUserExperiencesV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous response
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// sets experience type_name
+	IncludeTypeName bool `protobuf:"varint,4,opt,name=include_type_name,json=includeTypeName,proto3" json:"include_type_name,omitempty"`
+}
+
+func (x *ListUserExperiencesV1Request) Reset() {
+	*x = ListUserExperiencesV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserExperiencesV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserExperiencesV1Request) ProtoMessage() {}
+
+func (x *ListUserExperiencesV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserExperiencesV1Request.ProtoReflect.Descriptor instead.
+func (*ListUserExperiencesV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListUserExperiencesV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserExperiencesV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserExperiencesV1Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserExperiencesV1Request) GetIncludeTypeName() bool {
+	if x != nil {
+		return x.IncludeTypeName
+	}
+	return false
+}
+
+// Contains a page of user experiences sorted by from
+type ListUserExperiencesV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experiences []*Experience `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// token to request the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (x *ListUserExperiencesV1Response) Reset() {
+	*x = ListUserExperiencesV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserExperiencesV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserExperiencesV1Response) ProtoMessage() {}
+
+func (x *ListUserExperiencesV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserExperiencesV1Response.ProtoReflect.Descriptor instead.
+func (*ListUserExperiencesV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUserExperiencesV1Response) GetExperiences() []*Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *ListUserExperiencesV1Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListUserExperiencesV1Response) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// User id to summarize experiences of
+type GetUserExperienceSummaryV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeTypeName bool   `protobuf:"varint,2,opt,name=include_type_name,json=includeTypeName,proto3" json:"include_type_name,omitempty"`
+}
+
+func (x *GetUserExperienceSummaryV1Request) Reset() {
+	*x = GetUserExperienceSummaryV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserExperienceSummaryV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserExperienceSummaryV1Request) ProtoMessage() {}
+
+func (x *GetUserExperienceSummaryV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserExperienceSummaryV1Request.ProtoReflect.Descriptor instead.
+func (*GetUserExperienceSummaryV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUserExperienceSummaryV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserExperienceSummaryV1Request) GetIncludeTypeName() bool {
+	if x != nil {
+		return x.IncludeTypeName
+	}
+	return false
+}
+
+// Contains experience summaries sorted by type
+type GetUserExperienceSummaryV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types []*ExperienceTypeSummary `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *GetUserExperienceSummaryV1Response) Reset() {
+	*x = GetUserExperienceSummaryV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserExperienceSummaryV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserExperienceSummaryV1Response) ProtoMessage() {}
+
+func (x *GetUserExperienceSummaryV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserExperienceSummaryV1Response.ProtoReflect.Descriptor instead.
+func (*GetUserExperienceSummaryV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetUserExperienceSummaryV1Response) GetTypes() []*ExperienceTypeSummary {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// User id to normalize experiences of
+type NormalizeUserExperiencesV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DryRun bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *NormalizeUserExperiencesV1Request) Reset() {
+	*x = NormalizeUserExperiencesV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizeUserExperiencesV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeUserExperiencesV1Request) ProtoMessage() {}
+
+func (x *NormalizeUserExperiencesV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeUserExperiencesV1Request.ProtoReflect.Descriptor instead.
+func (*NormalizeUserExperiencesV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NormalizeUserExperiencesV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NormalizeUserExperiencesV1Request) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Contains removed experience ids and created experiences replacing them, created ids are not set in dry run
+type NormalizeUserExperiencesV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovedIds []uint64      `protobuf:"varint,1,rep,packed,name=removed_ids,json=removedIds,proto3" json:"removed_ids,omitempty"`
+	CreatedIds []uint64      `protobuf:"varint,2,rep,packed,name=created_ids,json=createdIds,proto3" json:"created_ids,omitempty"`
+	Created    []*Experience `protobuf:"bytes,3,rep,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *NormalizeUserExperiencesV1Response) Reset() {
+	*x = NormalizeUserExperiencesV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizeUserExperiencesV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeUserExperiencesV1Response) ProtoMessage() {}
+
+func (x *NormalizeUserExperiencesV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeUserExperiencesV1Response.ProtoReflect.Descriptor instead.
+func (*NormalizeUserExperiencesV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NormalizeUserExperiencesV1Response) GetRemovedIds() []uint64 {
+	if x != nil {
+		return x.RemovedIds
+	}
+	return nil
+}
+
+func (x *NormalizeUserExperiencesV1Response) GetCreatedIds() []uint64 {
+	if x != nil {
+		return x.CreatedIds
+	}
+	return nil
+}
+
+func (x *NormalizeUserExperiencesV1Response) GetCreated() []*Experience {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+// User experience of a type. Duration is a sum of merged experience intervals, ongoing experiences last until now.
+// Last to is not set if the user has ongoing experience of the type
+type ExperienceTypeSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      uint64               `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	TypeName  string               `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Duration  *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	MaxLevel  uint64               `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	FirstFrom *timestamp.Timestamp `protobuf:"bytes,5,opt,name=first_from,json=firstFrom,proto3" json:"first_from,omitempty"`
+	LastTo    *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_to,json=lastTo,proto3" json:"last_to,omitempty"`
+	Ongoing   bool                 `protobuf:"varint,7,opt,name=ongoing,proto3" json:"ongoing,omitempty"`
+}
+
+func (x *ExperienceTypeSummary) Reset() {
+	*x = ExperienceTypeSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceTypeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceTypeSummary) ProtoMessage() {}
+
+func (x *ExperienceTypeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceTypeSummary.ProtoReflect.Descriptor instead.
+func (*ExperienceTypeSummary) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExperienceTypeSummary) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ExperienceTypeSummary) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *ExperienceTypeSummary) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ExperienceTypeSummary) GetMaxLevel() uint64 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *ExperienceTypeSummary) GetFirstFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.FirstFrom
+	}
+	return nil
+}
+
+func (x *ExperienceTypeSummary) GetLastTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTo
+	}
+	return nil
+}
+
+func (x *ExperienceTypeSummary) GetOngoing() bool {
+	if x != nil {
+		return x.Ongoing
+	}
+	return false
+}
+
+// Experience change
+type ExperienceHistoryRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExperienceId uint64                         `protobuf:"varint,2,opt,name=experience_id,json=experienceId,proto3" json:"experience_id,omitempty"`
+	Action       ExperienceHistoryRecord_Action `protobuf:"varint,3,opt,name=action,proto3,enum=ocp.experience.api.ExperienceHistoryRecord_Action" json:"action,omitempty"`
+	// caller identity passed in x-actor metadata
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// experience state before the change, not set on create
+	Before    *Experience          `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After     *Experience          `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ExperienceHistoryRecord) Reset() {
+	*x = ExperienceHistoryRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceHistoryRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceHistoryRecord) ProtoMessage() {}
+
+func (x *ExperienceHistoryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceHistoryRecord.ProtoReflect.Descriptor instead.
+func (*ExperienceHistoryRecord) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExperienceHistoryRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExperienceHistoryRecord) GetExperienceId() uint64 {
+	if x != nil {
+		return x.ExperienceId
+	}
+	return 0
+}
+
+func (x *ExperienceHistoryRecord) GetAction() ExperienceHistoryRecord_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ExperienceHistoryRecord_CREATE
+}
+
+func (x *ExperienceHistoryRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ExperienceHistoryRecord) GetBefore() *Experience {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ExperienceHistoryRecord) GetAfter() *Experience {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ExperienceHistoryRecord) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Contains a batch of new experiences
+type MultiCreateExperienceV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experiences []*CreateExperienceV1Request        `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Mode        MultiCreateExperienceV1Request_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ocp.experience.api.MultiCreateExperienceV1Request_Mode" json:"mode,omitempty"`
+	// replayed requests with the key return the original response, idempotency-key header may be used instead
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *MultiCreateExperienceV1Request) Reset() {
+	*x = MultiCreateExperienceV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateExperienceV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateExperienceV1Request) ProtoMessage() {}
+
+func (x *MultiCreateExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*MultiCreateExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MultiCreateExperienceV1Request) GetExperiences() []*CreateExperienceV1Request {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *MultiCreateExperienceV1Request) GetMode() MultiCreateExperienceV1Request_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return MultiCreateExperienceV1Request_BATCHED
+}
+
+func (x *MultiCreateExperienceV1Request) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// Api returns created experience ids
+type MultiCreateExperienceV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// a result per experience in request order, returned in BEST_EFFORT mode only
+	Results []*ExperienceBatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiCreateExperienceV1Response) Reset() {
+	*x = MultiCreateExperienceV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateExperienceV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateExperienceV1Response) ProtoMessage() {}
+
+func (x *MultiCreateExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*MultiCreateExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MultiCreateExperienceV1Response) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MultiCreateExperienceV1Response) GetResults() []*ExperienceBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Contains a streamed experience to import
+type ImportExperiencesV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experience *CreateExperienceV1Request `protobuf:"bytes,1,opt,name=experience,proto3" json:"experience,omitempty"`
+}
+
+func (x *ImportExperiencesV1Request) Reset() {
+	*x = ImportExperiencesV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportExperiencesV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExperiencesV1Request) ProtoMessage() {}
+
+func (x *ImportExperiencesV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExperiencesV1Request.ProtoReflect.Descriptor instead.
+func (*ImportExperiencesV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImportExperiencesV1Request) GetExperience() *CreateExperienceV1Request {
+	if x != nil {
+		return x.Experience
+	}
+	return nil
+}
+
+// Import summary
+type ImportExperiencesV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accepted uint64 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected uint64 `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// first rejected experiences, at most 100 of them
+	Rejections []*ImportRejection `protobuf:"bytes,3,rep,name=rejections,proto3" json:"rejections,omitempty"`
+}
+
+func (x *ImportExperiencesV1Response) Reset() {
+	*x = ImportExperiencesV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportExperiencesV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExperiencesV1Response) ProtoMessage() {}
+
+func (x *ImportExperiencesV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExperiencesV1Response.ProtoReflect.Descriptor instead.
+func (*ImportExperiencesV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportExperiencesV1Response) GetAccepted() uint64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *ImportExperiencesV1Response) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ImportExperiencesV1Response) GetRejections() []*ImportRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+// Rejected experience
+type ImportRejection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// zero based number of experience in the stream
+	Row    uint64 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ImportRejection) Reset() {
+	*x = ImportRejection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRejection) ProtoMessage() {}
+
+func (x *ImportRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRejection.ProtoReflect.Descriptor instead.
+func (*ImportRejection) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportRejection) GetRow() uint64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Contains experiences to remove
+type MultiRemoveExperienceV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experiences []*RemoveExperienceV1Request `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+}
+
+func (x *MultiRemoveExperienceV1Request) Reset() {
+	*x = MultiRemoveExperienceV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveExperienceV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveExperienceV1Request) ProtoMessage() {}
+
+func (x *MultiRemoveExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*MultiRemoveExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MultiRemoveExperienceV1Request) GetExperiences() []*RemoveExperienceV1Request {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+// Contains a result per experience in request order
+type MultiRemoveExperienceV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ExperienceBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiRemoveExperienceV1Response) Reset() {
+	*x = MultiRemoveExperienceV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveExperienceV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveExperienceV1Response) ProtoMessage() {}
+
+func (x *MultiRemoveExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*MultiRemoveExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MultiRemoveExperienceV1Response) GetResults() []*ExperienceBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Contains experiences to update
+type MultiUpdateExperienceV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experiences []*UpdateExperienceV1Request `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+}
+
+func (x *MultiUpdateExperienceV1Request) Reset() {
+	*x = MultiUpdateExperienceV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateExperienceV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateExperienceV1Request) ProtoMessage() {}
+
+func (x *MultiUpdateExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*MultiUpdateExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MultiUpdateExperienceV1Request) GetExperiences() []*UpdateExperienceV1Request {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+// Contains a result per experience in request order
+type MultiUpdateExperienceV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ExperienceBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiUpdateExperienceV1Response) Reset() {
+	*x = MultiUpdateExperienceV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateExperienceV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateExperienceV1Response) ProtoMessage() {}
+
+func (x *MultiUpdateExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*MultiUpdateExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MultiUpdateExperienceV1Response) GetResults() []*ExperienceBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Result of a batch item
+type ExperienceBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status ExperienceBatchResult_Status `protobuf:"varint,2,opt,name=status,proto3,enum=ocp.experience.api.ExperienceBatchResult_Status" json:"status,omitempty"`
+	Error  string                       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ExperienceBatchResult) Reset() {
+	*x = ExperienceBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceBatchResult) ProtoMessage() {}
+
+func (x *ExperienceBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceBatchResult.ProtoReflect.Descriptor instead.
+func (*ExperienceBatchResult) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExperienceBatchResult) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExperienceBatchResult) GetStatus() ExperienceBatchResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ExperienceBatchResult_OK
+}
+
+func (x *ExperienceBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Updates experience info
+type UpdateExperienceV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// must be greater than 0 if it is updated
+	UserId uint64               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type   uint64               `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	From   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// not set to make experience ongoing if update mask contains to
+	To    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Level uint64               `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	// fields to update, zero values are written as is.
+	// If not set, only non-zero fields are updated
+	UpdateMask *field_mask.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// updates experience only if it has the version. Maps to If-Match header
+	ExpectedVersion uint64 `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *UpdateExperienceV1Request) Reset() {
+	*x = UpdateExperienceV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExperienceV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperienceV1Request) ProtoMessage() {}
+
+func (x *UpdateExperienceV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperienceV1Request.ProtoReflect.Descriptor instead.
+func (*UpdateExperienceV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateExperienceV1Request) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateExperienceV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateExperienceV1Request) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UpdateExperienceV1Request) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *UpdateExperienceV1Request) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *UpdateExperienceV1Request) GetLevel() uint64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UpdateExperienceV1Request) GetUpdateMask() *field_mask.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateExperienceV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// Update experience result, contains ids of removed experiences merged into the updated one
+type UpdateExperienceV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MergedIds []uint64 `protobuf:"varint,1,rep,packed,name=merged_ids,json=mergedIds,proto3" json:"merged_ids,omitempty"`
+}
+
+func (x *UpdateExperienceV1Response) Reset() {
+	*x = UpdateExperienceV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExperienceV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExperienceV1Response) ProtoMessage() {}
+
+func (x *UpdateExperienceV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExperienceV1Response.ProtoReflect.Descriptor instead.
+func (*UpdateExperienceV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateExperienceV1Response) GetMergedIds() []uint64 {
+	if x != nil {
+		return x.MergedIds
+	}
+	return nil
+}
+
+// Defines a user to find overlaps of, all users if not set, and a size and offset of overlap list
+type FindOverlapsV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *FindOverlapsV1Request) Reset() {
+	*x = FindOverlapsV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOverlapsV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOverlapsV1Request) ProtoMessage() {}
+
+func (x *FindOverlapsV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOverlapsV1Request.ProtoReflect.Descriptor instead.
+func (*FindOverlapsV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FindOverlapsV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FindOverlapsV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FindOverlapsV1Request) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Contains overlapping experience pairs sorted by ids
+type FindOverlapsV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overlaps []*ExperienceOverlap `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+}
+
+func (x *FindOverlapsV1Response) Reset() {
+	*x = FindOverlapsV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOverlapsV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOverlapsV1Response) ProtoMessage() {}
+
+func (x *FindOverlapsV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOverlapsV1Response.ProtoReflect.Descriptor instead.
+func (*FindOverlapsV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FindOverlapsV1Response) GetOverlaps() []*ExperienceOverlap {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+// Pair of experiences of the same user and type with overlapping periods, first id is less than second one
+type ExperienceOverlap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type     uint64 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	FirstId  uint64 `protobuf:"varint,3,opt,name=first_id,json=firstId,proto3" json:"first_id,omitempty"`
+	SecondId uint64 `protobuf:"varint,4,opt,name=second_id,json=secondId,proto3" json:"second_id,omitempty"`
+}
+
+func (x *ExperienceOverlap) Reset() {
+	*x = ExperienceOverlap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceOverlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceOverlap) ProtoMessage() {}
+
+func (x *ExperienceOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceOverlap.ProtoReflect.Descriptor instead.
+func (*ExperienceOverlap) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExperienceOverlap) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExperienceOverlap) GetType() uint64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ExperienceOverlap) GetFirstId() uint64 {
+	if x != nil {
+		return x.FirstId
+	}
+	return 0
+}
+
+func (x *ExperienceOverlap) GetSecondId() uint64 {
+	if x != nil {
+		return x.SecondId
+	}
+	return 0
+}
+
+// The below below related to API events that would be sent via Kafka
+type ExperienceAPIEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event     ExperienceAPIEvent_EventType `protobuf:"varint,2,opt,name=event,proto3,enum=ocp.experience.api.ExperienceAPIEvent_EventType" json:"event,omitempty"`
+	Error     string                       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	TraceSpan map[string]string            `protobuf:"bytes,4,rep,name=trace_span,json=traceSpan,proto3" json:"trace_span,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Entity    ExperienceAPIEvent_Entity    `protobuf:"varint,5,opt,name=entity,proto3,enum=ocp.experience.api.ExperienceAPIEvent_Entity" json:"entity,omitempty"`
+}
+
+func (x *ExperienceAPIEvent) Reset() {
+	*x = ExperienceAPIEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperienceAPIEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperienceAPIEvent) ProtoMessage() {}
+
+func (x *ExperienceAPIEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperienceAPIEvent.ProtoReflect.Descriptor instead.
+func (*ExperienceAPIEvent) Descriptor() ([]byte, []int) {
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExperienceAPIEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExperienceAPIEvent) GetEvent() ExperienceAPIEvent_EventType {
+	if x != nil {
+		return x.Event
+	}
+	return ExperienceAPIEvent_CREATE
+}
+
+func (x *ExperienceAPIEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExperienceAPIEvent) GetTraceSpan() map[string]string {
+	if x != nil {
+		return x.TraceSpan
+	}
+	return nil
+}
+
+func (x *ExperienceAPIEvent) GetEntity() ExperienceAPIEvent_Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return ExperienceAPIEvent_EXPERIENCE
+}
+
+var File_api_ocp_experience_api_ocp_experience_api_proto protoreflect.FileDescriptor
+
+var file_api_ocp_experience_api_ocp_experience_api_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05, 0x18, 0x90, 0x4e, 0x20, 0x00,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x60, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa3, 0x02,
+	0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x22, 0x43, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x06, 0x0a, 0x02, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x49, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x52, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x4f, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0x05, 0x22, 0xc0, 0x01, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0xf6,
+	0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x4b, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x64, 0x49, 0x64, 0x73, 0x22, 0x5f, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x35, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22,
+	0x62, 0x0a, 0x1b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32,
+	0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x1c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05, 0x20,
+	0x00, 0x18, 0x90, 0x4e, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x59, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x3a,
+	0x0a, 0x1f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x20, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x18, 0x40, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a,
+	0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x1e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x1c,
+	0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x45,
+	0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x06, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05, 0x20, 0x00, 0x18, 0x90, 0x4e, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x68, 0x0a, 0x1f, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05, 0x20, 0x00,
+	0x18, 0x90, 0x4e, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x71, 0x0a, 0x21,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x65, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x21, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x22, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12,
+	0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa6, 0x02, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x22, 0x94, 0x03, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x39,
+	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x22, 0xad, 0x02, 0x0a, 0x1e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x55, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x30, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x53, 0x54,
+	0x5f, 0x45, 0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x22, 0x78, 0x0a, 0x1f, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x43,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x75, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x57, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x1e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0x66, 0x0a, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7b, 0x0a, 0x1e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xec,
+	0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x63, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04,
+	0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x10, 0x05, 0x22, 0xbb, 0x02,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x1a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x64, 0x49, 0x64, 0x73, 0x22, 0x6a, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05,
+	0x20, 0x00, 0x18, 0x90, 0x4e, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x5b, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x73, 0x22, 0x78, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x22, 0xc7, 0x03, 0x0a, 0x12,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x50, 0x49, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x46, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x50, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x54, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x41, 0x50, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x45, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x50, 0x49, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x3c, 0x0a,
+	0x0e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x09, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x22, 0x2d, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x01, 0x32, 0xe9, 0x1d, 0x0a, 0x10, 0x4f, 0x63, 0x70, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x70, 0x69, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12,
+	0x2b, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x31,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x3a, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x89, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64,
+	0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x3a, 0x6f, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x70, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2f, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8f, 0x01,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x91, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2e, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x12, 0x32,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0xa5, 0x01,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x56, 0x31, 0x12, 0x35, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0xc1, 0x01, 0x0a, 0x1a, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x56, 0x31, 0x12, 0x35, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x3a, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa3, 0x01, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x56, 0x31, 0x12, 0x32, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x9b, 0x01,
+	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x56, 0x31, 0x12, 0x2e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x3a,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x12, 0xaa, 0x01, 0x0a, 0x17,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x32, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x17, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x56, 0x31, 0x12, 0x32, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x56, 0x31, 0x12,
+	0x30, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0xa8, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x12,
+	0x33, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa0, 0x01,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0xa5, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x1a, 0x19, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa2, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xae, 0x01,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0xae,
+	0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x1a, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0xaf, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a,
+	0x5a, 0x19, 0x3a, 0x01, 0x2a, 0x32, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x1a, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x42, 0x50, 0x5a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70, 0x2d, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x63,
+	0x70, 0x2d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x2d, 0x61, 0x70, 0x69,
+	0x3b, 0x6f, 0x63, 0x70, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_ocp_experience_api_ocp_experience_api_proto_rawDescOnce sync.Once
+	file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData = file_api_ocp_experience_api_ocp_experience_api_proto_rawDesc
+)
+
+func file_api_ocp_experience_api_ocp_experience_api_proto_rawDescGZIP() []byte {
+	file_api_ocp_experience_api_ocp_experience_api_proto_rawDescOnce.Do(func() {
+		file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData)
+	})
+	return file_api_ocp_experience_api_ocp_experience_api_proto_rawDescData
+}
+
+var file_api_ocp_experience_api_ocp_experience_api_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_api_ocp_experience_api_ocp_experience_api_proto_goTypes = []interface{}{
+	(ExperienceOrder_Field)(0),                 // 0: ocp.experience.api.ExperienceOrder.Field
+	(ExperienceHistoryRecord_Action)(0),        // 1: ocp.experience.api.ExperienceHistoryRecord.Action
+	(MultiCreateExperienceV1Request_Mode)(0),   // 2: ocp.experience.api.MultiCreateExperienceV1Request.Mode
+	(ExperienceBatchResult_Status)(0),          // 3: ocp.experience.api.ExperienceBatchResult.Status
+	(ExperienceAPIEvent_EventType)(0),          // 4: ocp.experience.api.ExperienceAPIEvent.EventType
+	(ExperienceAPIEvent_Entity)(0),             // 5: ocp.experience.api.ExperienceAPIEvent.Entity
+	(*ListExperienceV1Request)(nil),            // 6: ocp.experience.api.ListExperienceV1Request
+	(*ListExperienceV1StreamRequest)(nil),      // 7: ocp.experience.api.ListExperienceV1StreamRequest
+	(*ListExperienceV1StreamResponse)(nil),     // 8: ocp.experience.api.ListExperienceV1StreamResponse
+	(*ExperienceFilter)(nil),                   // 9: ocp.experience.api.ExperienceFilter
+	(*ExperienceOrder)(nil),                    // 10: ocp.experience.api.ExperienceOrder
+	(*ListExperienceV1Response)(nil),           // 11: ocp.experience.api.ListExperienceV1Response
+	(*CreateExperienceV1Request)(nil),          // 12: ocp.experience.api.CreateExperienceV1Request
+	(*CreateExperienceV1Response)(nil),         // 13: ocp.experience.api.CreateExperienceV1Response
+	(*RemoveExperienceV1Request)(nil),          // 14: ocp.experience.api.RemoveExperienceV1Request
+	(*RemoveExperienceV1Response)(nil),         // 15: ocp.experience.api.RemoveExperienceV1Response
+	(*RestoreExperienceV1Request)(nil),         // 16: ocp.experience.api.RestoreExperienceV1Request
+	(*RestoreExperienceV1Response)(nil),        // 17: ocp.experience.api.RestoreExperienceV1Response
+	(*DescribeExperienceV1Request)(nil),        // 18: ocp.experience.api.DescribeExperienceV1Request
+	(*DescribeExperienceV1Response)(nil),       // 19: ocp.experience.api.DescribeExperienceV1Response
+	(*Experience)(nil),                         // 20: ocp.experience.api.Experience
+	(*ExperienceType)(nil),                     // 21: ocp.experience.api.ExperienceType
+	(*ListExperienceTypesV1Request)(nil),       // 22: ocp.experience.api.ListExperienceTypesV1Request
+	(*ListExperienceTypesV1Response)(nil),      // 23: ocp.experience.api.ListExperienceTypesV1Response
+	(*DescribeExperienceTypeV1Request)(nil),    // 24: ocp.experience.api.DescribeExperienceTypeV1Request
+	(*DescribeExperienceTypeV1Response)(nil),   // 25: ocp.experience.api.DescribeExperienceTypeV1Response
+	(*CreateExperienceTypeV1Request)(nil),      // 26: ocp.experience.api.CreateExperienceTypeV1Request
+	(*CreateExperienceTypeV1Response)(nil),     // 27: ocp.experience.api.CreateExperienceTypeV1Response
+	(*UpdateExperienceTypeV1Request)(nil),      // 28: ocp.experience.api.UpdateExperienceTypeV1Request
+	(*UpdateExperienceTypeV1Response)(nil),     // 29: ocp.experience.api.UpdateExperienceTypeV1Response
+	(*RemoveExperienceTypeV1Request)(nil),      // 30: ocp.experience.api.RemoveExperienceTypeV1Request
+	(*RemoveExperienceTypeV1Response)(nil),     // 31: ocp.experience.api.RemoveExperienceTypeV1Response
+	(*ExperienceLevel)(nil),                    // 32: ocp.experience.api.ExperienceLevel
+	(*ListExperienceLevelsV1Request)(nil),      // 33: ocp.experience.api.ListExperienceLevelsV1Request
+	(*ListExperienceLevelsV1Response)(nil),     // 34: ocp.experience.api.ListExperienceLevelsV1Response
+	(*SetExperienceLevelsV1Request)(nil),       // 35: ocp.experience.api.SetExperienceLevelsV1Request
+	(*SetExperienceLevelsV1Response)(nil),      // 36: ocp.experience.api.SetExperienceLevelsV1Response
+	(*ListExperienceHistoryV1Request)(nil),     // 37: ocp.experience.api.ListExperienceHistoryV1Request
+	(*ListExperienceHistoryV1Response)(nil),    // 38: ocp.experience.api.ListExperienceHistoryV1Response
+	(*ListUserExperiencesV1Request)(nil),       // 39: ocp.experience.api.ListUserExperiencesV1Request
+	(*ListUserExperiencesV1Response)(nil),      // 40: ocp.experience.api.ListUserExperiencesV1Response
+	(*GetUserExperienceSummaryV1Request)(nil),  // 41: ocp.experience.api.GetUserExperienceSummaryV1Request
+	(*GetUserExperienceSummaryV1Response)(nil), // 42: ocp.experience.api.GetUserExperienceSummaryV1Response
+	(*NormalizeUserExperiencesV1Request)(nil),  // 43: ocp.experience.api.NormalizeUserExperiencesV1Request
+	(*NormalizeUserExperiencesV1Response)(nil), // 44: ocp.experience.api.NormalizeUserExperiencesV1Response
+	(*ExperienceTypeSummary)(nil),              // 45: ocp.experience.api.ExperienceTypeSummary
+	(*ExperienceHistoryRecord)(nil),            // 46: ocp.experience.api.ExperienceHistoryRecord
+	(*MultiCreateExperienceV1Request)(nil),     // 47: ocp.experience.api.MultiCreateExperienceV1Request
+	(*MultiCreateExperienceV1Response)(nil),    // 48: ocp.experience.api.MultiCreateExperienceV1Response
+	(*ImportExperiencesV1Request)(nil),         // 49: ocp.experience.api.ImportExperiencesV1Request
+	(*ImportExperiencesV1Response)(nil),        // 50: ocp.experience.api.ImportExperiencesV1Response
+	(*ImportRejection)(nil),                    // 51: ocp.experience.api.ImportRejection
+	(*MultiRemoveExperienceV1Request)(nil),     // 52: ocp.experience.api.MultiRemoveExperienceV1Request
+	(*MultiRemoveExperienceV1Response)(nil),    // 53: ocp.experience.api.MultiRemoveExperienceV1Response
+	(*MultiUpdateExperienceV1Request)(nil),     // 54: ocp.experience.api.MultiUpdateExperienceV1Request
+	(*MultiUpdateExperienceV1Response)(nil),    // 55: ocp.experience.api.MultiUpdateExperienceV1Response
+	(*ExperienceBatchResult)(nil),              // 56: ocp.experience.api.ExperienceBatchResult
+	(*UpdateExperienceV1Request)(nil),          // 57: ocp.experience.api.UpdateExperienceV1Request
+	(*UpdateExperienceV1Response)(nil),         // 58: ocp.experience.api.UpdateExperienceV1Response
+	(*FindOverlapsV1Request)(nil),              // 59: ocp.experience.api.FindOverlapsV1Request
+	(*FindOverlapsV1Response)(nil),             // 60: ocp.experience.api.FindOverlapsV1Response
+	(*ExperienceOverlap)(nil),                  // 61: ocp.experience.api.ExperienceOverlap
+	(*ExperienceAPIEvent)(nil),                 // 62: ocp.experience.api.ExperienceAPIEvent
+	nil,                                        // 63: ocp.experience.api.ExperienceAPIEvent.TraceSpanEntry
+	(*timestamp.Timestamp)(nil),                // 64: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 65: google.protobuf.Duration
+	(*field_mask.FieldMask)(nil),               // 66: google.protobuf.FieldMask
+}
+var file_api_ocp_experience_api_ocp_experience_api_proto_depIdxs = []int32{
+	9,  // 0: ocp.experience.api.ListExperienceV1Request.filter:type_name -> ocp.experience.api.ExperienceFilter
+	10, // 1: ocp.experience.api.ListExperienceV1Request.order_by:type_name -> ocp.experience.api.ExperienceOrder
+	9,  // 2: ocp.experience.api.ListExperienceV1StreamRequest.filter:type_name -> ocp.experience.api.ExperienceFilter
+	10, // 3: ocp.experience.api.ListExperienceV1StreamRequest.order_by:type_name -> ocp.experience.api.ExperienceOrder
+	20, // 4: ocp.experience.api.ListExperienceV1StreamResponse.experience:type_name -> ocp.experience.api.Experience
+	64, // 5: ocp.experience.api.ExperienceFilter.from:type_name -> google.protobuf.Timestamp
+	64, // 6: ocp.experience.api.ExperienceFilter.to:type_name -> google.protobuf.Timestamp
+	0,  // 7: ocp.experience.api.ExperienceOrder.field:type_name -> ocp.experience.api.ExperienceOrder.Field
+	20, // 8: ocp.experience.api.ListExperienceV1Response.experiences:type_name -> ocp.experience.api.Experience
+	64, // 9: ocp.experience.api.CreateExperienceV1Request.from:type_name -> google.protobuf.Timestamp
+	64, // 10: ocp.experience.api.CreateExperienceV1Request.to:type_name -> google.protobuf.Timestamp
+	20, // 11: ocp.experience.api.DescribeExperienceV1Response.experience:type_name -> ocp.experience.api.Experience
+	64, // 12: ocp.experience.api.Experience.from:type_name -> google.protobuf.Timestamp
+	64, // 13: ocp.experience.api.Experience.to:type_name -> google.protobuf.Timestamp
+	64, // 14: ocp.experience.api.Experience.deleted_at:type_name -> google.protobuf.Timestamp
+	21, // 15: ocp.experience.api.ListExperienceTypesV1Response.types:type_name -> ocp.experience.api.ExperienceType
+	21, // 16: ocp.experience.api.DescribeExperienceTypeV1Response.type:type_name -> ocp.experience.api.ExperienceType
+	65, // 17: ocp.experience.api.ExperienceLevel.min_duration:type_name -> google.protobuf.Duration
+	32, // 18: ocp.experience.api.ListExperienceLevelsV1Response.levels:type_name -> ocp.experience.api.ExperienceLevel
+	32, // 19: ocp.experience.api.SetExperienceLevelsV1Request.levels:type_name -> ocp.experience.api.ExperienceLevel
+	46, // 20: ocp.experience.api.ListExperienceHistoryV1Response.records:type_name -> ocp.experience.api.ExperienceHistoryRecord
+	20, // 21: ocp.experience.api.ListUserExperiencesV1Response.experiences:type_name -> ocp.experience.api.Experience
+	45, // 22: ocp.experience.api.GetUserExperienceSummaryV1Response.types:type_name -> ocp.experience.api.ExperienceTypeSummary
+	20, // 23: ocp.experience.api.NormalizeUserExperiencesV1Response.created:type_name -> ocp.experience.api.Experience
+	65, // 24: ocp.experience.api.ExperienceTypeSummary.duration:type_name -> google.protobuf.Duration
+	64, // 25: ocp.experience.api.ExperienceTypeSummary.first_from:type_name -> google.protobuf.Timestamp
+	64, // 26: ocp.experience.api.ExperienceTypeSummary.last_to:type_name -> google.protobuf.Timestamp
+	1,  // 27: ocp.experience.api.ExperienceHistoryRecord.action:type_name -> ocp.experience.api.ExperienceHistoryRecord.Action
+	20, // 28: ocp.experience.api.ExperienceHistoryRecord.before:type_name -> ocp.experience.api.Experience
+	20, // 29: ocp.experience.api.ExperienceHistoryRecord.after:type_name -> ocp.experience.api.Experience
+	64, // 30: ocp.experience.api.ExperienceHistoryRecord.created_at:type_name -> google.protobuf.Timestamp
+	12, // 31: ocp.experience.api.MultiCreateExperienceV1Request.experiences:type_name -> ocp.experience.api.CreateExperienceV1Request
+	2,  // 32: ocp.experience.api.MultiCreateExperienceV1Request.mode:type_name -> ocp.experience.api.MultiCreateExperienceV1Request.Mode
+	56, // 33: ocp.experience.api.MultiCreateExperienceV1Response.results:type_name -> ocp.experience.api.ExperienceBatchResult
+	12, // 34: ocp.experience.api.ImportExperiencesV1Request.experience:type_name -> ocp.experience.api.CreateExperienceV1Request
+	51, // 35: ocp.experience.api.ImportExperiencesV1Response.rejections:type_name -> ocp.experience.api.ImportRejection
+	14, // 36: ocp.experience.api.MultiRemoveExperienceV1Request.experiences:type_name -> ocp.experience.api.RemoveExperienceV1Request
+	56, // 37: ocp.experience.api.MultiRemoveExperienceV1Response.results:type_name -> ocp.experience.api.ExperienceBatchResult
+	57, // 38: ocp.experience.api.MultiUpdateExperienceV1Request.experiences:type_name -> ocp.experience.api.UpdateExperienceV1Request
+	56, // 39: ocp.experience.api.MultiUpdateExperienceV1Response.results:type_name -> ocp.experience.api.ExperienceBatchResult
+	3,  // 40: ocp.experience.api.ExperienceBatchResult.status:type_name -> ocp.experience.api.ExperienceBatchResult.Status
+	64, // 41: ocp.experience.api.UpdateExperienceV1Request.from:type_name -> google.protobuf.Timestamp
+	64, // 42: ocp.experience.api.UpdateExperienceV1Request.to:type_name -> google.protobuf.Timestamp
+	66, // 43: ocp.experience.api.UpdateExperienceV1Request.update_mask:type_name -> google.protobuf.FieldMask
+	61, // 44: ocp.experience.api.FindOverlapsV1Response.overlaps:type_name -> ocp.experience.api.ExperienceOverlap
+	4,  // 45: ocp.experience.api.ExperienceAPIEvent.event:type_name -> ocp.experience.api.ExperienceAPIEvent.EventType
+	63, // 46: ocp.experience.api.ExperienceAPIEvent.trace_span:type_name -> ocp.experience.api.ExperienceAPIEvent.TraceSpanEntry
+	5,  // 47: ocp.experience.api.ExperienceAPIEvent.entity:type_name -> ocp.experience.api.ExperienceAPIEvent.Entity
+	6,  // 48: ocp.experience.api.OcpExperienceApi.ListExperienceV1:input_type -> ocp.experience.api.ListExperienceV1Request
+	7,  // 49: ocp.experience.api.OcpExperienceApi.ListExperienceV1Stream:input_type -> ocp.experience.api.ListExperienceV1StreamRequest
+	59, // 50: ocp.experience.api.OcpExperienceApi.FindOverlapsV1:input_type -> ocp.experience.api.FindOverlapsV1Request
+	18, // 51: ocp.experience.api.OcpExperienceApi.DescribeExperienceV1:input_type -> ocp.experience.api.DescribeExperienceV1Request
+	12, // 52: ocp.experience.api.OcpExperienceApi.CreateExperienceV1:input_type -> ocp.experience.api.CreateExperienceV1Request
+	14, // 53: ocp.experience.api.OcpExperienceApi.RemoveExperienceV1:input_type -> ocp.experience.api.RemoveExperienceV1Request
+	16, // 54: ocp.experience.api.OcpExperienceApi.RestoreExperienceV1:input_type -> ocp.experience.api.RestoreExperienceV1Request
+	37, // 55: ocp.experience.api.OcpExperienceApi.ListExperienceHistoryV1:input_type -> ocp.experience.api.ListExperienceHistoryV1Request
+	39, // 56: ocp.experience.api.OcpExperienceApi.ListUserExperiencesV1:input_type -> ocp.experience.api.ListUserExperiencesV1Request
+	41, // 57: ocp.experience.api.OcpExperienceApi.GetUserExperienceSummaryV1:input_type -> ocp.experience.api.GetUserExperienceSummaryV1Request
+	43, // 58: ocp.experience.api.OcpExperienceApi.NormalizeUserExperiencesV1:input_type -> ocp.experience.api.NormalizeUserExperiencesV1Request
+	47, // 59: ocp.experience.api.OcpExperienceApi.MultiCreateExperienceV1:input_type -> ocp.experience.api.MultiCreateExperienceV1Request
+	49, // 60: ocp.experience.api.OcpExperienceApi.ImportExperiencesV1:input_type -> ocp.experience.api.ImportExperiencesV1Request
+	52, // 61: ocp.experience.api.OcpExperienceApi.MultiRemoveExperienceV1:input_type -> ocp.experience.api.MultiRemoveExperienceV1Request
+	54, // 62: ocp.experience.api.OcpExperienceApi.MultiUpdateExperienceV1:input_type -> ocp.experience.api.MultiUpdateExperienceV1Request
+	22, // 63: ocp.experience.api.OcpExperienceApi.ListExperienceTypesV1:input_type -> ocp.experience.api.ListExperienceTypesV1Request
+	24, // 64: ocp.experience.api.OcpExperienceApi.DescribeExperienceTypeV1:input_type -> ocp.experience.api.DescribeExperienceTypeV1Request
+	26, // 65: ocp.experience.api.OcpExperienceApi.CreateExperienceTypeV1:input_type -> ocp.experience.api.CreateExperienceTypeV1Request
+	28, // 66: ocp.experience.api.OcpExperienceApi.UpdateExperienceTypeV1:input_type -> ocp.experience.api.UpdateExperienceTypeV1Request
+	30, // 67: ocp.experience.api.OcpExperienceApi.RemoveExperienceTypeV1:input_type -> ocp.experience.api.RemoveExperienceTypeV1Request
+	33, // 68: ocp.experience.api.OcpExperienceApi.ListExperienceLevelsV1:input_type -> ocp.experience.api.ListExperienceLevelsV1Request
+	35, // 69: ocp.experience.api.OcpExperienceApi.SetExperienceLevelsV1:input_type -> ocp.experience.api.SetExperienceLevelsV1Request
+	57, // 70: ocp.experience.api.OcpExperienceApi.UpdateExperienceV1:input_type -> ocp.experience.api.UpdateExperienceV1Request
+	11, // 71: ocp.experience.api.OcpExperienceApi.ListExperienceV1:output_type -> ocp.experience.api.ListExperienceV1Response
+	8,  // 72: ocp.experience.api.OcpExperienceApi.ListExperienceV1Stream:output_type -> ocp.experience.api.ListExperienceV1StreamResponse
+	60, // 73: ocp.experience.api.OcpExperienceApi.FindOverlapsV1:output_type -> ocp.experience.api.FindOverlapsV1Response
+	19, // 74: ocp.experience.api.OcpExperienceApi.DescribeExperienceV1:output_type -> ocp.experience.api.DescribeExperienceV1Response
+	13, // 75: ocp.experience.api.OcpExperienceApi.CreateExperienceV1:output_type -> ocp.experience.api.CreateExperienceV1Response
+	15, // 76: ocp.experience.api.OcpExperienceApi.RemoveExperienceV1:output_type -> ocp.experience.api.RemoveExperienceV1Response
+	17, // 77: ocp.experience.api.OcpExperienceApi.RestoreExperienceV1:output_type -> ocp.experience.api.RestoreExperienceV1Response
+	38, // 78: ocp.experience.api.OcpExperienceApi.ListExperienceHistoryV1:output_type -> ocp.experience.api.ListExperienceHistoryV1Response
+	40, // 79: ocp.experience.api.OcpExperienceApi.ListUserExperiencesV1:output_type -> ocp.experience.api.ListUserExperiencesV1Response
+	42, // 80: ocp.experience.api.OcpExperienceApi.GetUserExperienceSummaryV1:output_type -> ocp.experience.api.GetUserExperienceSummaryV1Response
+	44, // 81: ocp.experience.api.OcpExperienceApi.NormalizeUserExperiencesV1:output_type -> ocp.experience.api.NormalizeUserExperiencesV1Response
+	48, // 82: ocp.experience.api.OcpExperienceApi.MultiCreateExperienceV1:output_type -> ocp.experience.api.MultiCreateExperienceV1Response
+	50, // 83: ocp.experience.api.OcpExperienceApi.ImportExperiencesV1:output_type -> ocp.experience.api.ImportExperiencesV1Response
+	53, // 84: ocp.experience.api.OcpExperienceApi.MultiRemoveExperienceV1:output_type -> ocp.experience.api.MultiRemoveExperienceV1Response
+	55, // 85: ocp.experience.api.OcpExperienceApi.MultiUpdateExperienceV1:output_type -> ocp.experience.api.MultiUpdateExperienceV1Response
+	23, // 86: ocp.experience.api.OcpExperienceApi.ListExperienceTypesV1:output_type -> ocp.experience.api.ListExperienceTypesV1Response
+	25, // 87: ocp.experience.api.OcpExperienceApi.DescribeExperienceTypeV1:output_type -> ocp.experience.api.DescribeExperienceTypeV1Response
+	27, // 88: ocp.experience.api.OcpExperienceApi.CreateExperienceTypeV1:output_type -> ocp.experience.api.CreateExperienceTypeV1Response
+	29, // 89: ocp.experience.api.OcpExperienceApi.UpdateExperienceTypeV1:output_type -> ocp.experience.api.UpdateExperienceTypeV1Response
+	31, // 90: ocp.experience.api.OcpExperienceApi.RemoveExperienceTypeV1:output_type -> ocp.experience.api.RemoveExperienceTypeV1Response
+	34, // 91: ocp.experience.api.OcpExperienceApi.ListExperienceLevelsV1:output_type -> ocp.experience.api.ListExperienceLevelsV1Response
+	36, // 92: ocp.experience.api.OcpExperienceApi.SetExperienceLevelsV1:output_type -> ocp.experience.api.SetExperienceLevelsV1Response
+	58, // 93: ocp.experience.api.OcpExperienceApi.UpdateExperienceV1:output_type -> ocp.experience.api.UpdateExperienceV1Response
+	71, // [71:94] is the sub-list for method output_type
+	48, // [48:71] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_api_ocp_experience_api_ocp_experience_api_proto_init() }
+func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
+	if File_api_ocp_experience_api_ocp_experience_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceV1StreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceV1StreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Experience); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceTypesV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceTypesV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeExperienceTypeV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeExperienceTypeV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExperienceTypeV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExperienceTypeV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateExperienceTypeV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateExperienceTypeV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveExperienceTypeV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveExperienceTypeV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceLevelsV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceLevelsV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExperienceLevelsV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExperienceLevelsV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceHistoryV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1148,8 +5132,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListExperienceV1Response); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExperienceHistoryV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1160,8 +5144,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExperienceV1Request); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserExperiencesV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1172,8 +5156,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExperienceV1Response); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserExperiencesV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1184,8 +5168,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveExperienceV1Request); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserExperienceSummaryV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1196,8 +5180,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveExperienceV1Response); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserExperienceSummaryV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1208,8 +5192,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeExperienceV1Request); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeUserExperiencesV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1220,8 +5204,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeExperienceV1Response); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeUserExperiencesV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1232,8 +5216,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Experience); i {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceTypeSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1244,7 +5228,19 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceHistoryRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MultiCreateExperienceV1Request); i {
 			case 0:
 				return &v.state
@@ -1256,7 +5252,7 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MultiCreateExperienceV1Response); i {
 			case 0:
 				return &v.state
@@ -1268,7 +5264,103 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportExperiencesV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportExperiencesV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRejection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateExperienceV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateExperienceV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateExperienceV1Request); i {
 			case 0:
 				return &v.state
@@ -1280,7 +5372,7 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateExperienceV1Response); i {
 			case 0:
 				return &v.state
@@ -1292,7 +5384,43 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOverlapsV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOverlapsV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperienceOverlap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_experience_api_ocp_experience_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExperienceAPIEvent); i {
 			case 0:
 				return &v.state
@@ -1310,8 +5438,8 @@ func file_api_ocp_experience_api_ocp_experience_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_ocp_experience_api_ocp_experience_api_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      6,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -55,6 +55,32 @@ func (m *ListExperienceV1Request) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListExperienceV1RequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListExperienceV1RequestValidationError{
+				field:  "OrderBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for SkipTotalCount
+
+	// no validation rules for IncludeTypeName
+
 	return nil
 }
 
@@ -114,35 +140,41 @@ var _ interface {
 	ErrorName() string
 } = ListExperienceV1RequestValidationError{}
 
-// Validate checks the field values on ListExperienceV1Response with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListExperienceV1StreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListExperienceV1Response) Validate() error {
+func (m *ListExperienceV1StreamRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetExperiences() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListExperienceV1ResponseValidationError{
-					field:  fmt.Sprintf("Experiences[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListExperienceV1StreamRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if v, ok := interface{}(m.GetOrderBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListExperienceV1StreamRequestValidationError{
+				field:  "OrderBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	return nil
 }
 
-// ListExperienceV1ResponseValidationError is the validation error returned by
-// ListExperienceV1Response.Validate if the designated constraints aren't met.
-type ListExperienceV1ResponseValidationError struct {
+// ListExperienceV1StreamRequestValidationError is the validation error
+// returned by ListExperienceV1StreamRequest.Validate if the designated
+// constraints aren't met.
+type ListExperienceV1StreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -150,24 +182,24 @@ type ListExperienceV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListExperienceV1ResponseValidationError) Field() string { return e.field }
+func (e ListExperienceV1StreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListExperienceV1ResponseValidationError) Reason() string { return e.reason }
+func (e ListExperienceV1StreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListExperienceV1ResponseValidationError) Cause() error { return e.cause }
+func (e ListExperienceV1StreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListExperienceV1ResponseValidationError) Key() bool { return e.key }
+func (e ListExperienceV1StreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListExperienceV1ResponseValidationError) ErrorName() string {
-	return "ListExperienceV1ResponseValidationError"
+func (e ListExperienceV1StreamRequestValidationError) ErrorName() string {
+	return "ListExperienceV1StreamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListExperienceV1ResponseValidationError) Error() string {
+func (e ListExperienceV1StreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -179,14 +211,14 @@ func (e ListExperienceV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListExperienceV1Response.%s: %s%s",
+		"invalid %sListExperienceV1StreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListExperienceV1ResponseValidationError{}
+var _ error = ListExperienceV1StreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -194,53 +226,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListExperienceV1ResponseValidationError{}
+} = ListExperienceV1StreamRequestValidationError{}
 
-// Validate checks the field values on CreateExperienceV1Request with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListExperienceV1StreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateExperienceV1Request) Validate() error {
+func (m *ListExperienceV1StreamResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetUserId() <= 0 {
-		return CreateExperienceV1RequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
-		}
-	}
-
-	// no validation rules for Type
-
-	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateExperienceV1RequestValidationError{
-				field:  "From",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+	if v, ok := interface{}(m.GetExperience()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateExperienceV1RequestValidationError{
-				field:  "To",
+			return ListExperienceV1StreamResponseValidationError{
+				field:  "Experience",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Level
-
 	return nil
 }
 
-// CreateExperienceV1RequestValidationError is the validation error returned by
-// CreateExperienceV1Request.Validate if the designated constraints aren't met.
-type CreateExperienceV1RequestValidationError struct {
+// ListExperienceV1StreamResponseValidationError is the validation error
+// returned by ListExperienceV1StreamResponse.Validate if the designated
+// constraints aren't met.
+type ListExperienceV1StreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -248,24 +260,24 @@ type CreateExperienceV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateExperienceV1RequestValidationError) Field() string { return e.field }
+func (e ListExperienceV1StreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateExperienceV1RequestValidationError) Reason() string { return e.reason }
+func (e ListExperienceV1StreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateExperienceV1RequestValidationError) Cause() error { return e.cause }
+func (e ListExperienceV1StreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateExperienceV1RequestValidationError) Key() bool { return e.key }
+func (e ListExperienceV1StreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateExperienceV1RequestValidationError) ErrorName() string {
-	return "CreateExperienceV1RequestValidationError"
+func (e ListExperienceV1StreamResponseValidationError) ErrorName() string {
+	return "ListExperienceV1StreamResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateExperienceV1RequestValidationError) Error() string {
+func (e ListExperienceV1StreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -277,14 +289,14 @@ func (e CreateExperienceV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateExperienceV1Request.%s: %s%s",
+		"invalid %sListExperienceV1StreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateExperienceV1RequestValidationError{}
+var _ error = ListExperienceV1StreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -292,24 +304,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateExperienceV1RequestValidationError{}
+} = ListExperienceV1StreamResponseValidationError{}
 
-// Validate checks the field values on CreateExperienceV1Response with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *CreateExperienceV1Response) Validate() error {
+// Validate checks the field values on ExperienceFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExperienceFilter) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	// no validation rules for UserId
+
+	// no validation rules for MinLevel
+
+	// no validation rules for MaxLevel
+
+	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceFilterValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceFilterValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeDeleted
+
+	// no validation rules for OngoingOnly
 
 	return nil
 }
 
-// CreateExperienceV1ResponseValidationError is the validation error returned
-// by CreateExperienceV1Response.Validate if the designated constraints aren't met.
-type CreateExperienceV1ResponseValidationError struct {
+// ExperienceFilterValidationError is the validation error returned by
+// ExperienceFilter.Validate if the designated constraints aren't met.
+type ExperienceFilterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -317,24 +357,22 @@ type CreateExperienceV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateExperienceV1ResponseValidationError) Field() string { return e.field }
+func (e ExperienceFilterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateExperienceV1ResponseValidationError) Reason() string { return e.reason }
+func (e ExperienceFilterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateExperienceV1ResponseValidationError) Cause() error { return e.cause }
+func (e ExperienceFilterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateExperienceV1ResponseValidationError) Key() bool { return e.key }
+func (e ExperienceFilterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateExperienceV1ResponseValidationError) ErrorName() string {
-	return "CreateExperienceV1ResponseValidationError"
-}
+func (e ExperienceFilterValidationError) ErrorName() string { return "ExperienceFilterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateExperienceV1ResponseValidationError) Error() string {
+func (e ExperienceFilterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -346,14 +384,14 @@ func (e CreateExperienceV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateExperienceV1Response.%s: %s%s",
+		"invalid %sExperienceFilter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateExperienceV1ResponseValidationError{}
+var _ error = ExperienceFilterValidationError{}
 
 var _ interface {
 	Field() string
@@ -361,29 +399,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateExperienceV1ResponseValidationError{}
+} = ExperienceFilterValidationError{}
 
-// Validate checks the field values on RemoveExperienceV1Request with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *RemoveExperienceV1Request) Validate() error {
+// Validate checks the field values on ExperienceOrder with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExperienceOrder) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return RemoveExperienceV1RequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
+	if _, ok := ExperienceOrder_Field_name[int32(m.GetField())]; !ok {
+		return ExperienceOrderValidationError{
+			field:  "Field",
+			reason: "value must be one of the defined enum values",
 		}
 	}
 
+	// no validation rules for Desc
+
 	return nil
 }
 
-// RemoveExperienceV1RequestValidationError is the validation error returned by
-// RemoveExperienceV1Request.Validate if the designated constraints aren't met.
-type RemoveExperienceV1RequestValidationError struct {
+// ExperienceOrderValidationError is the validation error returned by
+// ExperienceOrder.Validate if the designated constraints aren't met.
+type ExperienceOrderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -391,24 +431,22 @@ type RemoveExperienceV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveExperienceV1RequestValidationError) Field() string { return e.field }
+func (e ExperienceOrderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveExperienceV1RequestValidationError) Reason() string { return e.reason }
+func (e ExperienceOrderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveExperienceV1RequestValidationError) Cause() error { return e.cause }
+func (e ExperienceOrderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveExperienceV1RequestValidationError) Key() bool { return e.key }
+func (e ExperienceOrderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveExperienceV1RequestValidationError) ErrorName() string {
-	return "RemoveExperienceV1RequestValidationError"
-}
+func (e ExperienceOrderValidationError) ErrorName() string { return "ExperienceOrderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RemoveExperienceV1RequestValidationError) Error() string {
+func (e ExperienceOrderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -420,14 +458,14 @@ func (e RemoveExperienceV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveExperienceV1Request.%s: %s%s",
+		"invalid %sExperienceOrder.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveExperienceV1RequestValidationError{}
+var _ error = ExperienceOrderValidationError{}
 
 var _ interface {
 	Field() string
@@ -435,24 +473,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveExperienceV1RequestValidationError{}
+} = ExperienceOrderValidationError{}
 
-// Validate checks the field values on RemoveExperienceV1Response with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListExperienceV1Response with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RemoveExperienceV1Response) Validate() error {
+func (m *ListExperienceV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Removed
+	for idx, item := range m.GetExperiences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExperienceV1ResponseValidationError{
+					field:  fmt.Sprintf("Experiences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
+	// no validation rules for HasMore
 
 	return nil
 }
 
-// RemoveExperienceV1ResponseValidationError is the validation error returned
-// by RemoveExperienceV1Response.Validate if the designated constraints aren't met.
-type RemoveExperienceV1ResponseValidationError struct {
+// ListExperienceV1ResponseValidationError is the validation error returned by
+// ListExperienceV1Response.Validate if the designated constraints aren't met.
+type ListExperienceV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -460,24 +517,24 @@ type RemoveExperienceV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveExperienceV1ResponseValidationError) Field() string { return e.field }
+func (e ListExperienceV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveExperienceV1ResponseValidationError) Reason() string { return e.reason }
+func (e ListExperienceV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveExperienceV1ResponseValidationError) Cause() error { return e.cause }
+func (e ListExperienceV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveExperienceV1ResponseValidationError) Key() bool { return e.key }
+func (e ListExperienceV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveExperienceV1ResponseValidationError) ErrorName() string {
-	return "RemoveExperienceV1ResponseValidationError"
+func (e ListExperienceV1ResponseValidationError) ErrorName() string {
+	return "ListExperienceV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveExperienceV1ResponseValidationError) Error() string {
+func (e ListExperienceV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -489,14 +546,14 @@ func (e RemoveExperienceV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveExperienceV1Response.%s: %s%s",
+		"invalid %sListExperienceV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveExperienceV1ResponseValidationError{}
+var _ error = ListExperienceV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -504,30 +561,3549 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveExperienceV1ResponseValidationError{}
+} = ListExperienceV1ResponseValidationError{}
 
-// Validate checks the field values on DescribeExperienceV1Request with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateExperienceV1Request with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DescribeExperienceV1Request) Validate() error {
+func (m *CreateExperienceV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return DescribeExperienceV1RequestValidationError{
-			field:  "Id",
+	if m.GetUserId() <= 0 {
+		return CreateExperienceV1RequestValidationError{
+			field:  "UserId",
 			reason: "value must be greater than 0",
 		}
 	}
 
+	// no validation rules for Type
+
+	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperienceV1RequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperienceV1RequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Level
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		return CreateExperienceV1RequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+	}
+
+	return nil
+}
+
+// CreateExperienceV1RequestValidationError is the validation error returned by
+// CreateExperienceV1Request.Validate if the designated constraints aren't met.
+type CreateExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperienceV1RequestValidationError) ErrorName() string {
+	return "CreateExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperienceV1RequestValidationError{}
+
+// Validate checks the field values on CreateExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// CreateExperienceV1ResponseValidationError is the validation error returned
+// by CreateExperienceV1Response.Validate if the designated constraints aren't met.
+type CreateExperienceV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperienceV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperienceV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperienceV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperienceV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperienceV1ResponseValidationError) ErrorName() string {
+	return "CreateExperienceV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperienceV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperienceV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperienceV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperienceV1ResponseValidationError{}
+
+// Validate checks the field values on RemoveExperienceV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveExperienceV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RemoveExperienceV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for ExpectedVersion
+
+	return nil
+}
+
+// RemoveExperienceV1RequestValidationError is the validation error returned by
+// RemoveExperienceV1Request.Validate if the designated constraints aren't met.
+type RemoveExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveExperienceV1RequestValidationError) ErrorName() string {
+	return "RemoveExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveExperienceV1RequestValidationError{}
+
+// Validate checks the field values on RemoveExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Removed
+
+	return nil
+}
+
+// RemoveExperienceV1ResponseValidationError is the validation error returned
+// by RemoveExperienceV1Response.Validate if the designated constraints aren't met.
+type RemoveExperienceV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveExperienceV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveExperienceV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveExperienceV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveExperienceV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveExperienceV1ResponseValidationError) ErrorName() string {
+	return "RemoveExperienceV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveExperienceV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveExperienceV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveExperienceV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveExperienceV1ResponseValidationError{}
+
+// Validate checks the field values on RestoreExperienceV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreExperienceV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RestoreExperienceV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RestoreExperienceV1RequestValidationError is the validation error returned
+// by RestoreExperienceV1Request.Validate if the designated constraints aren't met.
+type RestoreExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreExperienceV1RequestValidationError) ErrorName() string {
+	return "RestoreExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreExperienceV1RequestValidationError{}
+
+// Validate checks the field values on RestoreExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Restored
+
+	return nil
+}
+
+// RestoreExperienceV1ResponseValidationError is the validation error returned
+// by RestoreExperienceV1Response.Validate if the designated constraints
+// aren't met.
+type RestoreExperienceV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreExperienceV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreExperienceV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreExperienceV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreExperienceV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreExperienceV1ResponseValidationError) ErrorName() string {
+	return "RestoreExperienceV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreExperienceV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreExperienceV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreExperienceV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreExperienceV1ResponseValidationError{}
+
+// Validate checks the field values on DescribeExperienceV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DescribeExperienceV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return DescribeExperienceV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for IncludeTypeName
+
+	return nil
+}
+
+// DescribeExperienceV1RequestValidationError is the validation error returned
+// by DescribeExperienceV1Request.Validate if the designated constraints
+// aren't met.
+type DescribeExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeExperienceV1RequestValidationError) ErrorName() string {
+	return "DescribeExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeExperienceV1RequestValidationError{}
+
+// Validate checks the field values on DescribeExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DescribeExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetExperience()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeExperienceV1ResponseValidationError{
+				field:  "Experience",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DescribeExperienceV1ResponseValidationError is the validation error returned
+// by DescribeExperienceV1Response.Validate if the designated constraints
+// aren't met.
+type DescribeExperienceV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeExperienceV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeExperienceV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeExperienceV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeExperienceV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeExperienceV1ResponseValidationError) ErrorName() string {
+	return "DescribeExperienceV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeExperienceV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeExperienceV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeExperienceV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeExperienceV1ResponseValidationError{}
+
+// Validate checks the field values on Experience with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Experience) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Level
+
+	// no validation rules for Version
+
+	if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TypeName
+
+	return nil
+}
+
+// ExperienceValidationError is the validation error returned by
+// Experience.Validate if the designated constraints aren't met.
+type ExperienceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperienceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperienceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperienceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperienceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperienceValidationError) ErrorName() string { return "ExperienceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExperienceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperience.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperienceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperienceValidationError{}
+
+// Validate checks the field values on ExperienceType with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ExperienceType) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// ExperienceTypeValidationError is the validation error returned by
+// ExperienceType.Validate if the designated constraints aren't met.
+type ExperienceTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperienceTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperienceTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperienceTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperienceTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperienceTypeValidationError) ErrorName() string { return "ExperienceTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExperienceTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperienceType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperienceTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperienceTypeValidationError{}
+
+// Validate checks the field values on ListExperienceTypesV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListExperienceTypesV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 10000 {
+		return ListExperienceTypesV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 10000]",
+		}
+	}
+
+	// no validation rules for Offset
+
+	return nil
+}
+
+// ListExperienceTypesV1RequestValidationError is the validation error returned
+// by ListExperienceTypesV1Request.Validate if the designated constraints
+// aren't met.
+type ListExperienceTypesV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExperienceTypesV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExperienceTypesV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExperienceTypesV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExperienceTypesV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExperienceTypesV1RequestValidationError) ErrorName() string {
+	return "ListExperienceTypesV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExperienceTypesV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExperienceTypesV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExperienceTypesV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExperienceTypesV1RequestValidationError{}
+
+// Validate checks the field values on ListExperienceTypesV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListExperienceTypesV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExperienceTypesV1ResponseValidationError{
+					field:  fmt.Sprintf("Types[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListExperienceTypesV1ResponseValidationError is the validation error
+// returned by ListExperienceTypesV1Response.Validate if the designated
+// constraints aren't met.
+type ListExperienceTypesV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExperienceTypesV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExperienceTypesV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExperienceTypesV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExperienceTypesV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExperienceTypesV1ResponseValidationError) ErrorName() string {
+	return "ListExperienceTypesV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExperienceTypesV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExperienceTypesV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExperienceTypesV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExperienceTypesV1ResponseValidationError{}
+
+// Validate checks the field values on DescribeExperienceTypeV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DescribeExperienceTypeV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return DescribeExperienceTypeV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// DescribeExperienceTypeV1RequestValidationError is the validation error
+// returned by DescribeExperienceTypeV1Request.Validate if the designated
+// constraints aren't met.
+type DescribeExperienceTypeV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeExperienceTypeV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeExperienceTypeV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeExperienceTypeV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeExperienceTypeV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeExperienceTypeV1RequestValidationError) ErrorName() string {
+	return "DescribeExperienceTypeV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeExperienceTypeV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeExperienceTypeV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeExperienceTypeV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeExperienceTypeV1RequestValidationError{}
+
+// Validate checks the field values on DescribeExperienceTypeV1Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DescribeExperienceTypeV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeExperienceTypeV1ResponseValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DescribeExperienceTypeV1ResponseValidationError is the validation error
+// returned by DescribeExperienceTypeV1Response.Validate if the designated
+// constraints aren't met.
+type DescribeExperienceTypeV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeExperienceTypeV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeExperienceTypeV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeExperienceTypeV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeExperienceTypeV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeExperienceTypeV1ResponseValidationError) ErrorName() string {
+	return "DescribeExperienceTypeV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeExperienceTypeV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeExperienceTypeV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeExperienceTypeV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeExperienceTypeV1ResponseValidationError{}
+
+// Validate checks the field values on CreateExperienceTypeV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateExperienceTypeV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return CreateExperienceTypeV1RequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// CreateExperienceTypeV1RequestValidationError is the validation error
+// returned by CreateExperienceTypeV1Request.Validate if the designated
+// constraints aren't met.
+type CreateExperienceTypeV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperienceTypeV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperienceTypeV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperienceTypeV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperienceTypeV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperienceTypeV1RequestValidationError) ErrorName() string {
+	return "CreateExperienceTypeV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperienceTypeV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperienceTypeV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperienceTypeV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperienceTypeV1RequestValidationError{}
+
+// Validate checks the field values on CreateExperienceTypeV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateExperienceTypeV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// CreateExperienceTypeV1ResponseValidationError is the validation error
+// returned by CreateExperienceTypeV1Response.Validate if the designated
+// constraints aren't met.
+type CreateExperienceTypeV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperienceTypeV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperienceTypeV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperienceTypeV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperienceTypeV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperienceTypeV1ResponseValidationError) ErrorName() string {
+	return "CreateExperienceTypeV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperienceTypeV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperienceTypeV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperienceTypeV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperienceTypeV1ResponseValidationError{}
+
+// Validate checks the field values on UpdateExperienceTypeV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateExperienceTypeV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return UpdateExperienceTypeV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return UpdateExperienceTypeV1RequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// UpdateExperienceTypeV1RequestValidationError is the validation error
+// returned by UpdateExperienceTypeV1Request.Validate if the designated
+// constraints aren't met.
+type UpdateExperienceTypeV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateExperienceTypeV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateExperienceTypeV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateExperienceTypeV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateExperienceTypeV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateExperienceTypeV1RequestValidationError) ErrorName() string {
+	return "UpdateExperienceTypeV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateExperienceTypeV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateExperienceTypeV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateExperienceTypeV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateExperienceTypeV1RequestValidationError{}
+
+// Validate checks the field values on UpdateExperienceTypeV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateExperienceTypeV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// UpdateExperienceTypeV1ResponseValidationError is the validation error
+// returned by UpdateExperienceTypeV1Response.Validate if the designated
+// constraints aren't met.
+type UpdateExperienceTypeV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateExperienceTypeV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateExperienceTypeV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateExperienceTypeV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateExperienceTypeV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateExperienceTypeV1ResponseValidationError) ErrorName() string {
+	return "UpdateExperienceTypeV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateExperienceTypeV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateExperienceTypeV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateExperienceTypeV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateExperienceTypeV1ResponseValidationError{}
+
+// Validate checks the field values on RemoveExperienceTypeV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveExperienceTypeV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return RemoveExperienceTypeV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RemoveExperienceTypeV1RequestValidationError is the validation error
+// returned by RemoveExperienceTypeV1Request.Validate if the designated
+// constraints aren't met.
+type RemoveExperienceTypeV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveExperienceTypeV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveExperienceTypeV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveExperienceTypeV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveExperienceTypeV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveExperienceTypeV1RequestValidationError) ErrorName() string {
+	return "RemoveExperienceTypeV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveExperienceTypeV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveExperienceTypeV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveExperienceTypeV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveExperienceTypeV1RequestValidationError{}
+
+// Validate checks the field values on RemoveExperienceTypeV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveExperienceTypeV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Removed
+
+	return nil
+}
+
+// RemoveExperienceTypeV1ResponseValidationError is the validation error
+// returned by RemoveExperienceTypeV1Response.Validate if the designated
+// constraints aren't met.
+type RemoveExperienceTypeV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveExperienceTypeV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveExperienceTypeV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveExperienceTypeV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveExperienceTypeV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveExperienceTypeV1ResponseValidationError) ErrorName() string {
+	return "RemoveExperienceTypeV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveExperienceTypeV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveExperienceTypeV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveExperienceTypeV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveExperienceTypeV1ResponseValidationError{}
+
+// Validate checks the field values on ExperienceLevel with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExperienceLevel) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetLevel() < 1 {
+		return ExperienceLevelValidationError{
+			field:  "Level",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return ExperienceLevelValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMinDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceLevelValidationError{
+				field:  "MinDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExperienceLevelValidationError is the validation error returned by
+// ExperienceLevel.Validate if the designated constraints aren't met.
+type ExperienceLevelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperienceLevelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperienceLevelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperienceLevelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperienceLevelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperienceLevelValidationError) ErrorName() string { return "ExperienceLevelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExperienceLevelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperienceLevel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperienceLevelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperienceLevelValidationError{}
+
+// Validate checks the field values on ListExperienceLevelsV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListExperienceLevelsV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetTypeId() <= 0 {
+		return ListExperienceLevelsV1RequestValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// ListExperienceLevelsV1RequestValidationError is the validation error
+// returned by ListExperienceLevelsV1Request.Validate if the designated
+// constraints aren't met.
+type ListExperienceLevelsV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExperienceLevelsV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExperienceLevelsV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExperienceLevelsV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExperienceLevelsV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExperienceLevelsV1RequestValidationError) ErrorName() string {
+	return "ListExperienceLevelsV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExperienceLevelsV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExperienceLevelsV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExperienceLevelsV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExperienceLevelsV1RequestValidationError{}
+
+// Validate checks the field values on ListExperienceLevelsV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListExperienceLevelsV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetLevels() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExperienceLevelsV1ResponseValidationError{
+					field:  fmt.Sprintf("Levels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListExperienceLevelsV1ResponseValidationError is the validation error
+// returned by ListExperienceLevelsV1Response.Validate if the designated
+// constraints aren't met.
+type ListExperienceLevelsV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExperienceLevelsV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExperienceLevelsV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExperienceLevelsV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExperienceLevelsV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExperienceLevelsV1ResponseValidationError) ErrorName() string {
+	return "ListExperienceLevelsV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExperienceLevelsV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExperienceLevelsV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExperienceLevelsV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExperienceLevelsV1ResponseValidationError{}
+
+// Validate checks the field values on SetExperienceLevelsV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetExperienceLevelsV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetTypeId() <= 0 {
+		return SetExperienceLevelsV1RequestValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if len(m.GetLevels()) > 100 {
+		return SetExperienceLevelsV1RequestValidationError{
+			field:  "Levels",
+			reason: "value must contain no more than 100 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetLevels() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetExperienceLevelsV1RequestValidationError{
+					field:  fmt.Sprintf("Levels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SetExperienceLevelsV1RequestValidationError is the validation error returned
+// by SetExperienceLevelsV1Request.Validate if the designated constraints
+// aren't met.
+type SetExperienceLevelsV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetExperienceLevelsV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetExperienceLevelsV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetExperienceLevelsV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetExperienceLevelsV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetExperienceLevelsV1RequestValidationError) ErrorName() string {
+	return "SetExperienceLevelsV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetExperienceLevelsV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetExperienceLevelsV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetExperienceLevelsV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetExperienceLevelsV1RequestValidationError{}
+
+// Validate checks the field values on SetExperienceLevelsV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetExperienceLevelsV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// SetExperienceLevelsV1ResponseValidationError is the validation error
+// returned by SetExperienceLevelsV1Response.Validate if the designated
+// constraints aren't met.
+type SetExperienceLevelsV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetExperienceLevelsV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetExperienceLevelsV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetExperienceLevelsV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetExperienceLevelsV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetExperienceLevelsV1ResponseValidationError) ErrorName() string {
+	return "SetExperienceLevelsV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetExperienceLevelsV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetExperienceLevelsV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetExperienceLevelsV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetExperienceLevelsV1ResponseValidationError{}
+
+// Validate checks the field values on ListExperienceHistoryV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListExperienceHistoryV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return ListExperienceHistoryV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 10000 {
+		return ListExperienceHistoryV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 10000]",
+		}
+	}
+
+	// no validation rules for Offset
+
+	return nil
+}
+
+// ListExperienceHistoryV1RequestValidationError is the validation error
+// returned by ListExperienceHistoryV1Request.Validate if the designated
+// constraints aren't met.
+type ListExperienceHistoryV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExperienceHistoryV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExperienceHistoryV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExperienceHistoryV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExperienceHistoryV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExperienceHistoryV1RequestValidationError) ErrorName() string {
+	return "ListExperienceHistoryV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExperienceHistoryV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExperienceHistoryV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExperienceHistoryV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExperienceHistoryV1RequestValidationError{}
+
+// Validate checks the field values on ListExperienceHistoryV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListExperienceHistoryV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExperienceHistoryV1ResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListExperienceHistoryV1ResponseValidationError is the validation error
+// returned by ListExperienceHistoryV1Response.Validate if the designated
+// constraints aren't met.
+type ListExperienceHistoryV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExperienceHistoryV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExperienceHistoryV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExperienceHistoryV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExperienceHistoryV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExperienceHistoryV1ResponseValidationError) ErrorName() string {
+	return "ListExperienceHistoryV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExperienceHistoryV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExperienceHistoryV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExperienceHistoryV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExperienceHistoryV1ResponseValidationError{}
+
+// Validate checks the field values on ListUserExperiencesV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListUserExperiencesV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetUserId() <= 0 {
+		return ListUserExperiencesV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 10000 {
+		return ListUserExperiencesV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 10000]",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTypeName
+
+	return nil
+}
+
+// ListUserExperiencesV1RequestValidationError is the validation error returned
+// by ListUserExperiencesV1Request.Validate if the designated constraints
+// aren't met.
+type ListUserExperiencesV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserExperiencesV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserExperiencesV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserExperiencesV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserExperiencesV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserExperiencesV1RequestValidationError) ErrorName() string {
+	return "ListUserExperiencesV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserExperiencesV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserExperiencesV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserExperiencesV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserExperiencesV1RequestValidationError{}
+
+// Validate checks the field values on ListUserExperiencesV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListUserExperiencesV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetExperiences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserExperiencesV1ResponseValidationError{
+					field:  fmt.Sprintf("Experiences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	return nil
+}
+
+// ListUserExperiencesV1ResponseValidationError is the validation error
+// returned by ListUserExperiencesV1Response.Validate if the designated
+// constraints aren't met.
+type ListUserExperiencesV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserExperiencesV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserExperiencesV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserExperiencesV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserExperiencesV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserExperiencesV1ResponseValidationError) ErrorName() string {
+	return "ListUserExperiencesV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserExperiencesV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserExperiencesV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserExperiencesV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserExperiencesV1ResponseValidationError{}
+
+// Validate checks the field values on GetUserExperienceSummaryV1Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GetUserExperienceSummaryV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetUserId() <= 0 {
+		return GetUserExperienceSummaryV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for IncludeTypeName
+
+	return nil
+}
+
+// GetUserExperienceSummaryV1RequestValidationError is the validation error
+// returned by GetUserExperienceSummaryV1Request.Validate if the designated
+// constraints aren't met.
+type GetUserExperienceSummaryV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserExperienceSummaryV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserExperienceSummaryV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserExperienceSummaryV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserExperienceSummaryV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserExperienceSummaryV1RequestValidationError) ErrorName() string {
+	return "GetUserExperienceSummaryV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserExperienceSummaryV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserExperienceSummaryV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserExperienceSummaryV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserExperienceSummaryV1RequestValidationError{}
+
+// Validate checks the field values on GetUserExperienceSummaryV1Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GetUserExperienceSummaryV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserExperienceSummaryV1ResponseValidationError{
+					field:  fmt.Sprintf("Types[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetUserExperienceSummaryV1ResponseValidationError is the validation error
+// returned by GetUserExperienceSummaryV1Response.Validate if the designated
+// constraints aren't met.
+type GetUserExperienceSummaryV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserExperienceSummaryV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserExperienceSummaryV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserExperienceSummaryV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserExperienceSummaryV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserExperienceSummaryV1ResponseValidationError) ErrorName() string {
+	return "GetUserExperienceSummaryV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserExperienceSummaryV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserExperienceSummaryV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserExperienceSummaryV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserExperienceSummaryV1ResponseValidationError{}
+
+// Validate checks the field values on NormalizeUserExperiencesV1Request with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *NormalizeUserExperiencesV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetUserId() <= 0 {
+		return NormalizeUserExperiencesV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for DryRun
+
+	return nil
+}
+
+// NormalizeUserExperiencesV1RequestValidationError is the validation error
+// returned by NormalizeUserExperiencesV1Request.Validate if the designated
+// constraints aren't met.
+type NormalizeUserExperiencesV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NormalizeUserExperiencesV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NormalizeUserExperiencesV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NormalizeUserExperiencesV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NormalizeUserExperiencesV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NormalizeUserExperiencesV1RequestValidationError) ErrorName() string {
+	return "NormalizeUserExperiencesV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NormalizeUserExperiencesV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNormalizeUserExperiencesV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NormalizeUserExperiencesV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NormalizeUserExperiencesV1RequestValidationError{}
+
+// Validate checks the field values on NormalizeUserExperiencesV1Response with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *NormalizeUserExperiencesV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NormalizeUserExperiencesV1ResponseValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// NormalizeUserExperiencesV1ResponseValidationError is the validation error
+// returned by NormalizeUserExperiencesV1Response.Validate if the designated
+// constraints aren't met.
+type NormalizeUserExperiencesV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NormalizeUserExperiencesV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NormalizeUserExperiencesV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NormalizeUserExperiencesV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NormalizeUserExperiencesV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NormalizeUserExperiencesV1ResponseValidationError) ErrorName() string {
+	return "NormalizeUserExperiencesV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NormalizeUserExperiencesV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNormalizeUserExperiencesV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NormalizeUserExperiencesV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NormalizeUserExperiencesV1ResponseValidationError{}
+
+// Validate checks the field values on ExperienceTypeSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExperienceTypeSummary) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for TypeName
+
+	if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceTypeSummaryValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxLevel
+
+	if v, ok := interface{}(m.GetFirstFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceTypeSummaryValidationError{
+				field:  "FirstFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceTypeSummaryValidationError{
+				field:  "LastTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ongoing
+
+	return nil
+}
+
+// ExperienceTypeSummaryValidationError is the validation error returned by
+// ExperienceTypeSummary.Validate if the designated constraints aren't met.
+type ExperienceTypeSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperienceTypeSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperienceTypeSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperienceTypeSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperienceTypeSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperienceTypeSummaryValidationError) ErrorName() string {
+	return "ExperienceTypeSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExperienceTypeSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperienceTypeSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperienceTypeSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperienceTypeSummaryValidationError{}
+
+// Validate checks the field values on ExperienceHistoryRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExperienceHistoryRecord) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for ExperienceId
+
+	// no validation rules for Action
+
+	// no validation rules for Actor
+
+	if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceHistoryRecordValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceHistoryRecordValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperienceHistoryRecordValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExperienceHistoryRecordValidationError is the validation error returned by
+// ExperienceHistoryRecord.Validate if the designated constraints aren't met.
+type ExperienceHistoryRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperienceHistoryRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperienceHistoryRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperienceHistoryRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperienceHistoryRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperienceHistoryRecordValidationError) ErrorName() string {
+	return "ExperienceHistoryRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExperienceHistoryRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperienceHistoryRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperienceHistoryRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperienceHistoryRecordValidationError{}
+
+// Validate checks the field values on MultiCreateExperienceV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiCreateExperienceV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetExperiences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateExperienceV1RequestValidationError{
+					field:  fmt.Sprintf("Experiences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if _, ok := MultiCreateExperienceV1Request_Mode_name[int32(m.GetMode())]; !ok {
+		return MultiCreateExperienceV1RequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		return MultiCreateExperienceV1RequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+	}
+
+	return nil
+}
+
+// MultiCreateExperienceV1RequestValidationError is the validation error
+// returned by MultiCreateExperienceV1Request.Validate if the designated
+// constraints aren't met.
+type MultiCreateExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateExperienceV1RequestValidationError) ErrorName() string {
+	return "MultiCreateExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateExperienceV1RequestValidationError{}
+
+// Validate checks the field values on MultiCreateExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiCreateExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateExperienceV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiCreateExperienceV1ResponseValidationError is the validation error
+// returned by MultiCreateExperienceV1Response.Validate if the designated
+// constraints aren't met.
+type MultiCreateExperienceV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateExperienceV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateExperienceV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateExperienceV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateExperienceV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateExperienceV1ResponseValidationError) ErrorName() string {
+	return "MultiCreateExperienceV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateExperienceV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateExperienceV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateExperienceV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateExperienceV1ResponseValidationError{}
+
+// Validate checks the field values on ImportExperiencesV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportExperiencesV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetExperience() == nil {
+		return ImportExperiencesV1RequestValidationError{
+			field:  "Experience",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetExperience()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportExperiencesV1RequestValidationError{
+				field:  "Experience",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ImportExperiencesV1RequestValidationError is the validation error returned
+// by ImportExperiencesV1Request.Validate if the designated constraints aren't met.
+type ImportExperiencesV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportExperiencesV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportExperiencesV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportExperiencesV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportExperiencesV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportExperiencesV1RequestValidationError) ErrorName() string {
+	return "ImportExperiencesV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportExperiencesV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportExperiencesV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportExperiencesV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportExperiencesV1RequestValidationError{}
+
+// Validate checks the field values on ImportExperiencesV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportExperiencesV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Accepted
+
+	// no validation rules for Rejected
+
+	for idx, item := range m.GetRejections() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportExperiencesV1ResponseValidationError{
+					field:  fmt.Sprintf("Rejections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportExperiencesV1ResponseValidationError is the validation error returned
+// by ImportExperiencesV1Response.Validate if the designated constraints
+// aren't met.
+type ImportExperiencesV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportExperiencesV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportExperiencesV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportExperiencesV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportExperiencesV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportExperiencesV1ResponseValidationError) ErrorName() string {
+	return "ImportExperiencesV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportExperiencesV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportExperiencesV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportExperiencesV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportExperiencesV1ResponseValidationError{}
+
+// Validate checks the field values on ImportRejection with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ImportRejection) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Row
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// ImportRejectionValidationError is the validation error returned by
+// ImportRejection.Validate if the designated constraints aren't met.
+type ImportRejectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRejectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRejectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRejectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRejectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRejectionValidationError) ErrorName() string { return "ImportRejectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRejectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRejection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRejectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRejectionValidationError{}
+
+// Validate checks the field values on MultiRemoveExperienceV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiRemoveExperienceV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetExperiences()) < 1 {
+		return MultiRemoveExperienceV1RequestValidationError{
+			field:  "Experiences",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetExperiences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiRemoveExperienceV1RequestValidationError{
+					field:  fmt.Sprintf("Experiences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiRemoveExperienceV1RequestValidationError is the validation error
+// returned by MultiRemoveExperienceV1Request.Validate if the designated
+// constraints aren't met.
+type MultiRemoveExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveExperienceV1RequestValidationError) ErrorName() string {
+	return "MultiRemoveExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveExperienceV1RequestValidationError{}
+
+// Validate checks the field values on MultiRemoveExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiRemoveExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiRemoveExperienceV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiRemoveExperienceV1ResponseValidationError is the validation error
+// returned by MultiRemoveExperienceV1Response.Validate if the designated
+// constraints aren't met.
+type MultiRemoveExperienceV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveExperienceV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveExperienceV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveExperienceV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveExperienceV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveExperienceV1ResponseValidationError) ErrorName() string {
+	return "MultiRemoveExperienceV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveExperienceV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveExperienceV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveExperienceV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveExperienceV1ResponseValidationError{}
+
+// Validate checks the field values on MultiUpdateExperienceV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiUpdateExperienceV1Request) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetExperiences()) < 1 {
+		return MultiUpdateExperienceV1RequestValidationError{
+			field:  "Experiences",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetExperiences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateExperienceV1RequestValidationError{
+					field:  fmt.Sprintf("Experiences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiUpdateExperienceV1RequestValidationError is the validation error
+// returned by MultiUpdateExperienceV1Request.Validate if the designated
+// constraints aren't met.
+type MultiUpdateExperienceV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateExperienceV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateExperienceV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateExperienceV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateExperienceV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateExperienceV1RequestValidationError) ErrorName() string {
+	return "MultiUpdateExperienceV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateExperienceV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateExperienceV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateExperienceV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateExperienceV1RequestValidationError{}
+
+// Validate checks the field values on MultiUpdateExperienceV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiUpdateExperienceV1Response) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateExperienceV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// DescribeExperienceV1RequestValidationError is the validation error returned
-// by DescribeExperienceV1Request.Validate if the designated constraints
-// aren't met.
-type DescribeExperienceV1RequestValidationError struct {
+// MultiUpdateExperienceV1ResponseValidationError is the validation error
+// returned by MultiUpdateExperienceV1Response.Validate if the designated
+// constraints aren't met.
+type MultiUpdateExperienceV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -535,24 +4111,24 @@ type DescribeExperienceV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeExperienceV1RequestValidationError) Field() string { return e.field }
+func (e MultiUpdateExperienceV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeExperienceV1RequestValidationError) Reason() string { return e.reason }
+func (e MultiUpdateExperienceV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeExperienceV1RequestValidationError) Cause() error { return e.cause }
+func (e MultiUpdateExperienceV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeExperienceV1RequestValidationError) Key() bool { return e.key }
+func (e MultiUpdateExperienceV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeExperienceV1RequestValidationError) ErrorName() string {
-	return "DescribeExperienceV1RequestValidationError"
+func (e MultiUpdateExperienceV1ResponseValidationError) ErrorName() string {
+	return "MultiUpdateExperienceV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeExperienceV1RequestValidationError) Error() string {
+func (e MultiUpdateExperienceV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -564,14 +4140,14 @@ func (e DescribeExperienceV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeExperienceV1Request.%s: %s%s",
+		"invalid %sMultiUpdateExperienceV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeExperienceV1RequestValidationError{}
+var _ error = MultiUpdateExperienceV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -579,33 +4155,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeExperienceV1RequestValidationError{}
+} = MultiUpdateExperienceV1ResponseValidationError{}
 
-// Validate checks the field values on DescribeExperienceV1Response with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExperienceBatchResult with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DescribeExperienceV1Response) Validate() error {
+func (m *ExperienceBatchResult) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetExperience()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeExperienceV1ResponseValidationError{
-				field:  "Experience",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Error
 
 	return nil
 }
 
-// DescribeExperienceV1ResponseValidationError is the validation error returned
-// by DescribeExperienceV1Response.Validate if the designated constraints
-// aren't met.
-type DescribeExperienceV1ResponseValidationError struct {
+// ExperienceBatchResultValidationError is the validation error returned by
+// ExperienceBatchResult.Validate if the designated constraints aren't met.
+type ExperienceBatchResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -613,24 +4184,24 @@ type DescribeExperienceV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeExperienceV1ResponseValidationError) Field() string { return e.field }
+func (e ExperienceBatchResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeExperienceV1ResponseValidationError) Reason() string { return e.reason }
+func (e ExperienceBatchResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeExperienceV1ResponseValidationError) Cause() error { return e.cause }
+func (e ExperienceBatchResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeExperienceV1ResponseValidationError) Key() bool { return e.key }
+func (e ExperienceBatchResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeExperienceV1ResponseValidationError) ErrorName() string {
-	return "DescribeExperienceV1ResponseValidationError"
+func (e ExperienceBatchResultValidationError) ErrorName() string {
+	return "ExperienceBatchResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeExperienceV1ResponseValidationError) Error() string {
+func (e ExperienceBatchResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -642,14 +4213,14 @@ func (e DescribeExperienceV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeExperienceV1Response.%s: %s%s",
+		"invalid %sExperienceBatchResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeExperienceV1ResponseValidationError{}
+var _ error = ExperienceBatchResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -657,16 +4228,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeExperienceV1ResponseValidationError{}
+} = ExperienceBatchResultValidationError{}
 
-// Validate checks the field values on Experience with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Experience) Validate() error {
+// Validate checks the field values on UpdateExperienceV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateExperienceV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	if m.GetId() <= 0 {
+		return UpdateExperienceV1RequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+	}
 
 	// no validation rules for UserId
 
@@ -674,7 +4251,7 @@ func (m *Experience) Validate() error {
 
 	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExperienceValidationError{
+			return UpdateExperienceV1RequestValidationError{
 				field:  "From",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -684,7 +4261,7 @@ func (m *Experience) Validate() error {
 
 	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExperienceValidationError{
+			return UpdateExperienceV1RequestValidationError{
 				field:  "To",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -694,12 +4271,24 @@ func (m *Experience) Validate() error {
 
 	// no validation rules for Level
 
+	if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateExperienceV1RequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedVersion
+
 	return nil
 }
 
-// ExperienceValidationError is the validation error returned by
-// Experience.Validate if the designated constraints aren't met.
-type ExperienceValidationError struct {
+// UpdateExperienceV1RequestValidationError is the validation error returned by
+// UpdateExperienceV1Request.Validate if the designated constraints aren't met.
+type UpdateExperienceV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -707,22 +4296,24 @@ type ExperienceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExperienceValidationError) Field() string { return e.field }
+func (e UpdateExperienceV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExperienceValidationError) Reason() string { return e.reason }
+func (e UpdateExperienceV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExperienceValidationError) Cause() error { return e.cause }
+func (e UpdateExperienceV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExperienceValidationError) Key() bool { return e.key }
+func (e UpdateExperienceV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExperienceValidationError) ErrorName() string { return "ExperienceValidationError" }
+func (e UpdateExperienceV1RequestValidationError) ErrorName() string {
+	return "UpdateExperienceV1RequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExperienceValidationError) Error() string {
+func (e UpdateExperienceV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -734,14 +4325,14 @@ func (e ExperienceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExperience.%s: %s%s",
+		"invalid %sUpdateExperienceV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExperienceValidationError{}
+var _ error = UpdateExperienceV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -749,38 +4340,22 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExperienceValidationError{}
+} = UpdateExperienceV1RequestValidationError{}
 
-// Validate checks the field values on MultiCreateExperienceV1Request with the
+// Validate checks the field values on UpdateExperienceV1Response with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MultiCreateExperienceV1Request) Validate() error {
+func (m *UpdateExperienceV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetExperiences() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MultiCreateExperienceV1RequestValidationError{
-					field:  fmt.Sprintf("Experiences[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	return nil
 }
 
-// MultiCreateExperienceV1RequestValidationError is the validation error
-// returned by MultiCreateExperienceV1Request.Validate if the designated
-// constraints aren't met.
-type MultiCreateExperienceV1RequestValidationError struct {
+// UpdateExperienceV1ResponseValidationError is the validation error returned
+// by UpdateExperienceV1Response.Validate if the designated constraints aren't met.
+type UpdateExperienceV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -788,24 +4363,24 @@ type MultiCreateExperienceV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateExperienceV1RequestValidationError) Field() string { return e.field }
+func (e UpdateExperienceV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateExperienceV1RequestValidationError) Reason() string { return e.reason }
+func (e UpdateExperienceV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateExperienceV1RequestValidationError) Cause() error { return e.cause }
+func (e UpdateExperienceV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateExperienceV1RequestValidationError) Key() bool { return e.key }
+func (e UpdateExperienceV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateExperienceV1RequestValidationError) ErrorName() string {
-	return "MultiCreateExperienceV1RequestValidationError"
+func (e UpdateExperienceV1ResponseValidationError) ErrorName() string {
+	return "UpdateExperienceV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateExperienceV1RequestValidationError) Error() string {
+func (e UpdateExperienceV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -817,14 +4392,14 @@ func (e MultiCreateExperienceV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateExperienceV1Request.%s: %s%s",
+		"invalid %sUpdateExperienceV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateExperienceV1RequestValidationError{}
+var _ error = UpdateExperienceV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -832,23 +4407,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateExperienceV1RequestValidationError{}
+} = UpdateExperienceV1ResponseValidationError{}
 
-// Validate checks the field values on MultiCreateExperienceV1Response with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on FindOverlapsV1Request with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MultiCreateExperienceV1Response) Validate() error {
+func (m *FindOverlapsV1Request) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for UserId
+
+	if val := m.GetLimit(); val <= 0 || val > 10000 {
+		return FindOverlapsV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 10000]",
+		}
+	}
+
+	// no validation rules for Offset
+
 	return nil
 }
 
-// MultiCreateExperienceV1ResponseValidationError is the validation error
-// returned by MultiCreateExperienceV1Response.Validate if the designated
-// constraints aren't met.
-type MultiCreateExperienceV1ResponseValidationError struct {
+// FindOverlapsV1RequestValidationError is the validation error returned by
+// FindOverlapsV1Request.Validate if the designated constraints aren't met.
+type FindOverlapsV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -856,24 +4441,24 @@ type MultiCreateExperienceV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateExperienceV1ResponseValidationError) Field() string { return e.field }
+func (e FindOverlapsV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateExperienceV1ResponseValidationError) Reason() string { return e.reason }
+func (e FindOverlapsV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateExperienceV1ResponseValidationError) Cause() error { return e.cause }
+func (e FindOverlapsV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateExperienceV1ResponseValidationError) Key() bool { return e.key }
+func (e FindOverlapsV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateExperienceV1ResponseValidationError) ErrorName() string {
-	return "MultiCreateExperienceV1ResponseValidationError"
+func (e FindOverlapsV1RequestValidationError) ErrorName() string {
+	return "FindOverlapsV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateExperienceV1ResponseValidationError) Error() string {
+func (e FindOverlapsV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -885,14 +4470,14 @@ func (e MultiCreateExperienceV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateExperienceV1Response.%s: %s%s",
+		"invalid %sFindOverlapsV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateExperienceV1ResponseValidationError{}
+var _ error = FindOverlapsV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -900,60 +4485,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateExperienceV1ResponseValidationError{}
+} = FindOverlapsV1RequestValidationError{}
 
-// Validate checks the field values on UpdateExperienceV1Request with the rules
+// Validate checks the field values on FindOverlapsV1Response with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateExperienceV1Request) Validate() error {
+func (m *FindOverlapsV1Response) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetId() <= 0 {
-		return UpdateExperienceV1RequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
-		}
-	}
-
-	if m.GetUserId() <= 0 {
-		return UpdateExperienceV1RequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than 0",
-		}
-	}
-
-	// no validation rules for Type
+	for idx, item := range m.GetOverlaps() {
+		_, _ = idx, item
 
-	if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateExperienceV1RequestValidationError{
-				field:  "From",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindOverlapsV1ResponseValidationError{
+					field:  fmt.Sprintf("Overlaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
-	}
 
-	if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateExperienceV1RequestValidationError{
-				field:  "To",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
 	}
 
-	// no validation rules for Level
-
 	return nil
 }
 
-// UpdateExperienceV1RequestValidationError is the validation error returned by
-// UpdateExperienceV1Request.Validate if the designated constraints aren't met.
-type UpdateExperienceV1RequestValidationError struct {
+// FindOverlapsV1ResponseValidationError is the validation error returned by
+// FindOverlapsV1Response.Validate if the designated constraints aren't met.
+type FindOverlapsV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -961,24 +4523,24 @@ type UpdateExperienceV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateExperienceV1RequestValidationError) Field() string { return e.field }
+func (e FindOverlapsV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateExperienceV1RequestValidationError) Reason() string { return e.reason }
+func (e FindOverlapsV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateExperienceV1RequestValidationError) Cause() error { return e.cause }
+func (e FindOverlapsV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateExperienceV1RequestValidationError) Key() bool { return e.key }
+func (e FindOverlapsV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateExperienceV1RequestValidationError) ErrorName() string {
-	return "UpdateExperienceV1RequestValidationError"
+func (e FindOverlapsV1ResponseValidationError) ErrorName() string {
+	return "FindOverlapsV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateExperienceV1RequestValidationError) Error() string {
+func (e FindOverlapsV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -990,14 +4552,14 @@ func (e UpdateExperienceV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateExperienceV1Request.%s: %s%s",
+		"invalid %sFindOverlapsV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateExperienceV1RequestValidationError{}
+var _ error = FindOverlapsV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1005,22 +4567,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateExperienceV1RequestValidationError{}
+} = FindOverlapsV1ResponseValidationError{}
 
-// Validate checks the field values on UpdateExperienceV1Response with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpdateExperienceV1Response) Validate() error {
+// Validate checks the field values on ExperienceOverlap with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExperienceOverlap) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	// no validation rules for FirstId
+
+	// no validation rules for SecondId
+
 	return nil
 }
 
-// UpdateExperienceV1ResponseValidationError is the validation error returned
-// by UpdateExperienceV1Response.Validate if the designated constraints aren't met.
-type UpdateExperienceV1ResponseValidationError struct {
+// ExperienceOverlapValidationError is the validation error returned by
+// ExperienceOverlap.Validate if the designated constraints aren't met.
+type ExperienceOverlapValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1028,24 +4598,24 @@ type UpdateExperienceV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateExperienceV1ResponseValidationError) Field() string { return e.field }
+func (e ExperienceOverlapValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateExperienceV1ResponseValidationError) Reason() string { return e.reason }
+func (e ExperienceOverlapValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateExperienceV1ResponseValidationError) Cause() error { return e.cause }
+func (e ExperienceOverlapValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateExperienceV1ResponseValidationError) Key() bool { return e.key }
+func (e ExperienceOverlapValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateExperienceV1ResponseValidationError) ErrorName() string {
-	return "UpdateExperienceV1ResponseValidationError"
+func (e ExperienceOverlapValidationError) ErrorName() string {
+	return "ExperienceOverlapValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateExperienceV1ResponseValidationError) Error() string {
+func (e ExperienceOverlapValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1057,14 +4627,14 @@ func (e UpdateExperienceV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateExperienceV1Response.%s: %s%s",
+		"invalid %sExperienceOverlap.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateExperienceV1ResponseValidationError{}
+var _ error = ExperienceOverlapValidationError{}
 
 var _ interface {
 	Field() string
@@ -1072,7 +4642,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateExperienceV1ResponseValidationError{}
+} = ExperienceOverlapValidationError{}
 
 // Validate checks the field values on ExperienceAPIEvent with the rules
 // defined in the proto definition for this message. If any rules are
@@ -1090,6 +4660,8 @@ func (m *ExperienceAPIEvent) Validate() error {
 
 	// no validation rules for TraceSpan
 
+	// no validation rules for Entity
+
 	return nil
 }
 
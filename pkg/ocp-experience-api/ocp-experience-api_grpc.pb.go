@@ -20,14 +20,50 @@ const _ = grpc.SupportPackageIsVersion7
 type OcpExperienceApiClient interface {
 	// ListExperienceV1 returns a list of experiences
 	ListExperienceV1(ctx context.Context, in *ListExperienceV1Request, opts ...grpc.CallOption) (*ListExperienceV1Response, error)
+	// ListExperienceV1Stream streams all experiences matching filter, for exports
+	ListExperienceV1Stream(ctx context.Context, in *ListExperienceV1StreamRequest, opts ...grpc.CallOption) (OcpExperienceApi_ListExperienceV1StreamClient, error)
+	// FindOverlapsV1 returns pairs of stored experiences of the same user and type with overlapping periods
+	FindOverlapsV1(ctx context.Context, in *FindOverlapsV1Request, opts ...grpc.CallOption) (*FindOverlapsV1Response, error)
 	// DescribeExperienceV1 returns detailed information of an experience
 	DescribeExperienceV1(ctx context.Context, in *DescribeExperienceV1Request, opts ...grpc.CallOption) (*DescribeExperienceV1Response, error)
 	// CreateExperienceV1 creates new experience. Returns created object id
 	CreateExperienceV1(ctx context.Context, in *CreateExperienceV1Request, opts ...grpc.CallOption) (*CreateExperienceV1Response, error)
 	// RemoveExperienceV1 removes experience by id. Returns a removing result
 	RemoveExperienceV1(ctx context.Context, in *RemoveExperienceV1Request, opts ...grpc.CallOption) (*RemoveExperienceV1Response, error)
+	// RestoreExperienceV1 restores removed experience by id. Returns a restoring result
+	RestoreExperienceV1(ctx context.Context, in *RestoreExperienceV1Request, opts ...grpc.CallOption) (*RestoreExperienceV1Response, error)
+	// ListExperienceHistoryV1 returns changes made to an experience
+	ListExperienceHistoryV1(ctx context.Context, in *ListExperienceHistoryV1Request, opts ...grpc.CallOption) (*ListExperienceHistoryV1Response, error)
+	// ListUserExperiencesV1 returns a page of user experiences sorted by from
+	ListUserExperiencesV1(ctx context.Context, in *ListUserExperiencesV1Request, opts ...grpc.CallOption) (*ListUserExperiencesV1Response, error)
+	// GetUserExperienceSummaryV1 returns user experience duration, maximum level and dates per type.
+	// Overlapping experiences of a type are merged
+	GetUserExperienceSummaryV1(ctx context.Context, in *GetUserExperienceSummaryV1Request, opts ...grpc.CallOption) (*GetUserExperienceSummaryV1Response, error)
+	// NormalizeUserExperiencesV1 merges overlapping experiences of a user type and adjacent ones of the same level.
+	// Merged experiences are removed and replaced with created ones, nothing is changed in dry run
+	NormalizeUserExperiencesV1(ctx context.Context, in *NormalizeUserExperiencesV1Request, opts ...grpc.CallOption) (*NormalizeUserExperiencesV1Response, error)
 	// MultiCreateExperienceV1 creates multiple experiences, returns array of new ids
 	MultiCreateExperienceV1(ctx context.Context, in *MultiCreateExperienceV1Request, opts ...grpc.CallOption) (*MultiCreateExperienceV1Response, error)
+	// ImportExperiencesV1 creates streamed experiences, returns import summary when stream is closed
+	ImportExperiencesV1(ctx context.Context, opts ...grpc.CallOption) (OcpExperienceApi_ImportExperiencesV1Client, error)
+	// MultiRemoveExperienceV1 removes multiple experiences, returns a result per experience
+	MultiRemoveExperienceV1(ctx context.Context, in *MultiRemoveExperienceV1Request, opts ...grpc.CallOption) (*MultiRemoveExperienceV1Response, error)
+	// MultiUpdateExperienceV1 updates multiple experiences, returns a result per experience
+	MultiUpdateExperienceV1(ctx context.Context, in *MultiUpdateExperienceV1Request, opts ...grpc.CallOption) (*MultiUpdateExperienceV1Response, error)
+	// ListExperienceTypesV1 returns a list of experience types
+	ListExperienceTypesV1(ctx context.Context, in *ListExperienceTypesV1Request, opts ...grpc.CallOption) (*ListExperienceTypesV1Response, error)
+	// DescribeExperienceTypeV1 returns experience type by id
+	DescribeExperienceTypeV1(ctx context.Context, in *DescribeExperienceTypeV1Request, opts ...grpc.CallOption) (*DescribeExperienceTypeV1Response, error)
+	// CreateExperienceTypeV1 adds experience type to the catalog. Returns created type id
+	CreateExperienceTypeV1(ctx context.Context, in *CreateExperienceTypeV1Request, opts ...grpc.CallOption) (*CreateExperienceTypeV1Response, error)
+	// UpdateExperienceTypeV1 renames experience type
+	UpdateExperienceTypeV1(ctx context.Context, in *UpdateExperienceTypeV1Request, opts ...grpc.CallOption) (*UpdateExperienceTypeV1Response, error)
+	// RemoveExperienceTypeV1 removes experience type which is not used by experiences. Returns a removing result
+	RemoveExperienceTypeV1(ctx context.Context, in *RemoveExperienceTypeV1Request, opts ...grpc.CallOption) (*RemoveExperienceTypeV1Response, error)
+	// ListExperienceLevelsV1 returns the level ladder of experience type
+	ListExperienceLevelsV1(ctx context.Context, in *ListExperienceLevelsV1Request, opts ...grpc.CallOption) (*ListExperienceLevelsV1Response, error)
+	// SetExperienceLevelsV1 replaces the level ladder of experience type, an empty ladder allows any level
+	SetExperienceLevelsV1(ctx context.Context, in *SetExperienceLevelsV1Request, opts ...grpc.CallOption) (*SetExperienceLevelsV1Response, error)
 	// UpdateExperienceV1 updates experience data
 	UpdateExperienceV1(ctx context.Context, in *UpdateExperienceV1Request, opts ...grpc.CallOption) (*UpdateExperienceV1Response, error)
 }
@@ -49,6 +85,47 @@ func (c *ocpExperienceApiClient) ListExperienceV1(ctx context.Context, in *ListE
 	return out, nil
 }
 
+func (c *ocpExperienceApiClient) ListExperienceV1Stream(ctx context.Context, in *ListExperienceV1StreamRequest, opts ...grpc.CallOption) (OcpExperienceApi_ListExperienceV1StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpExperienceApi_ServiceDesc.Streams[0], "/ocp.experience.api.OcpExperienceApi/ListExperienceV1Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpExperienceApiListExperienceV1StreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OcpExperienceApi_ListExperienceV1StreamClient interface {
+	Recv() (*ListExperienceV1StreamResponse, error)
+	grpc.ClientStream
+}
+
+type ocpExperienceApiListExperienceV1StreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ocpExperienceApiListExperienceV1StreamClient) Recv() (*ListExperienceV1StreamResponse, error) {
+	m := new(ListExperienceV1StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpExperienceApiClient) FindOverlapsV1(ctx context.Context, in *FindOverlapsV1Request, opts ...grpc.CallOption) (*FindOverlapsV1Response, error) {
+	out := new(FindOverlapsV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/FindOverlapsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpExperienceApiClient) DescribeExperienceV1(ctx context.Context, in *DescribeExperienceV1Request, opts ...grpc.CallOption) (*DescribeExperienceV1Response, error) {
 	out := new(DescribeExperienceV1Response)
 	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/DescribeExperienceV1", in, out, opts...)
@@ -76,6 +153,51 @@ func (c *ocpExperienceApiClient) RemoveExperienceV1(ctx context.Context, in *Rem
 	return out, nil
 }
 
+func (c *ocpExperienceApiClient) RestoreExperienceV1(ctx context.Context, in *RestoreExperienceV1Request, opts ...grpc.CallOption) (*RestoreExperienceV1Response, error) {
+	out := new(RestoreExperienceV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/RestoreExperienceV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) ListExperienceHistoryV1(ctx context.Context, in *ListExperienceHistoryV1Request, opts ...grpc.CallOption) (*ListExperienceHistoryV1Response, error) {
+	out := new(ListExperienceHistoryV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/ListExperienceHistoryV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) ListUserExperiencesV1(ctx context.Context, in *ListUserExperiencesV1Request, opts ...grpc.CallOption) (*ListUserExperiencesV1Response, error) {
+	out := new(ListUserExperiencesV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/ListUserExperiencesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) GetUserExperienceSummaryV1(ctx context.Context, in *GetUserExperienceSummaryV1Request, opts ...grpc.CallOption) (*GetUserExperienceSummaryV1Response, error) {
+	out := new(GetUserExperienceSummaryV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/GetUserExperienceSummaryV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) NormalizeUserExperiencesV1(ctx context.Context, in *NormalizeUserExperiencesV1Request, opts ...grpc.CallOption) (*NormalizeUserExperiencesV1Response, error) {
+	out := new(NormalizeUserExperiencesV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/NormalizeUserExperiencesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpExperienceApiClient) MultiCreateExperienceV1(ctx context.Context, in *MultiCreateExperienceV1Request, opts ...grpc.CallOption) (*MultiCreateExperienceV1Response, error) {
 	out := new(MultiCreateExperienceV1Response)
 	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/MultiCreateExperienceV1", in, out, opts...)
@@ -85,6 +207,121 @@ func (c *ocpExperienceApiClient) MultiCreateExperienceV1(ctx context.Context, in
 	return out, nil
 }
 
+func (c *ocpExperienceApiClient) ImportExperiencesV1(ctx context.Context, opts ...grpc.CallOption) (OcpExperienceApi_ImportExperiencesV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpExperienceApi_ServiceDesc.Streams[1], "/ocp.experience.api.OcpExperienceApi/ImportExperiencesV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpExperienceApiImportExperiencesV1Client{stream}
+	return x, nil
+}
+
+type OcpExperienceApi_ImportExperiencesV1Client interface {
+	Send(*ImportExperiencesV1Request) error
+	CloseAndRecv() (*ImportExperiencesV1Response, error)
+	grpc.ClientStream
+}
+
+type ocpExperienceApiImportExperiencesV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *ocpExperienceApiImportExperiencesV1Client) Send(m *ImportExperiencesV1Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ocpExperienceApiImportExperiencesV1Client) CloseAndRecv() (*ImportExperiencesV1Response, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportExperiencesV1Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpExperienceApiClient) MultiRemoveExperienceV1(ctx context.Context, in *MultiRemoveExperienceV1Request, opts ...grpc.CallOption) (*MultiRemoveExperienceV1Response, error) {
+	out := new(MultiRemoveExperienceV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/MultiRemoveExperienceV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) MultiUpdateExperienceV1(ctx context.Context, in *MultiUpdateExperienceV1Request, opts ...grpc.CallOption) (*MultiUpdateExperienceV1Response, error) {
+	out := new(MultiUpdateExperienceV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/MultiUpdateExperienceV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) ListExperienceTypesV1(ctx context.Context, in *ListExperienceTypesV1Request, opts ...grpc.CallOption) (*ListExperienceTypesV1Response, error) {
+	out := new(ListExperienceTypesV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/ListExperienceTypesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) DescribeExperienceTypeV1(ctx context.Context, in *DescribeExperienceTypeV1Request, opts ...grpc.CallOption) (*DescribeExperienceTypeV1Response, error) {
+	out := new(DescribeExperienceTypeV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/DescribeExperienceTypeV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) CreateExperienceTypeV1(ctx context.Context, in *CreateExperienceTypeV1Request, opts ...grpc.CallOption) (*CreateExperienceTypeV1Response, error) {
+	out := new(CreateExperienceTypeV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/CreateExperienceTypeV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) UpdateExperienceTypeV1(ctx context.Context, in *UpdateExperienceTypeV1Request, opts ...grpc.CallOption) (*UpdateExperienceTypeV1Response, error) {
+	out := new(UpdateExperienceTypeV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/UpdateExperienceTypeV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) RemoveExperienceTypeV1(ctx context.Context, in *RemoveExperienceTypeV1Request, opts ...grpc.CallOption) (*RemoveExperienceTypeV1Response, error) {
+	out := new(RemoveExperienceTypeV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/RemoveExperienceTypeV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) ListExperienceLevelsV1(ctx context.Context, in *ListExperienceLevelsV1Request, opts ...grpc.CallOption) (*ListExperienceLevelsV1Response, error) {
+	out := new(ListExperienceLevelsV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/ListExperienceLevelsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpExperienceApiClient) SetExperienceLevelsV1(ctx context.Context, in *SetExperienceLevelsV1Request, opts ...grpc.CallOption) (*SetExperienceLevelsV1Response, error) {
+	out := new(SetExperienceLevelsV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/SetExperienceLevelsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpExperienceApiClient) UpdateExperienceV1(ctx context.Context, in *UpdateExperienceV1Request, opts ...grpc.CallOption) (*UpdateExperienceV1Response, error) {
 	out := new(UpdateExperienceV1Response)
 	err := c.cc.Invoke(ctx, "/ocp.experience.api.OcpExperienceApi/UpdateExperienceV1", in, out, opts...)
@@ -100,14 +337,50 @@ func (c *ocpExperienceApiClient) UpdateExperienceV1(ctx context.Context, in *Upd
 type OcpExperienceApiServer interface {
 	// ListExperienceV1 returns a list of experiences
 	ListExperienceV1(context.Context, *ListExperienceV1Request) (*ListExperienceV1Response, error)
+	// ListExperienceV1Stream streams all experiences matching filter, for exports
+	ListExperienceV1Stream(*ListExperienceV1StreamRequest, OcpExperienceApi_ListExperienceV1StreamServer) error
+	// FindOverlapsV1 returns pairs of stored experiences of the same user and type with overlapping periods
+	FindOverlapsV1(context.Context, *FindOverlapsV1Request) (*FindOverlapsV1Response, error)
 	// DescribeExperienceV1 returns detailed information of an experience
 	DescribeExperienceV1(context.Context, *DescribeExperienceV1Request) (*DescribeExperienceV1Response, error)
 	// CreateExperienceV1 creates new experience. Returns created object id
 	CreateExperienceV1(context.Context, *CreateExperienceV1Request) (*CreateExperienceV1Response, error)
 	// RemoveExperienceV1 removes experience by id. Returns a removing result
 	RemoveExperienceV1(context.Context, *RemoveExperienceV1Request) (*RemoveExperienceV1Response, error)
+	// RestoreExperienceV1 restores removed experience by id. Returns a restoring result
+	RestoreExperienceV1(context.Context, *RestoreExperienceV1Request) (*RestoreExperienceV1Response, error)
+	// ListExperienceHistoryV1 returns changes made to an experience
+	ListExperienceHistoryV1(context.Context, *ListExperienceHistoryV1Request) (*ListExperienceHistoryV1Response, error)
+	// ListUserExperiencesV1 returns a page of user experiences sorted by from
+	ListUserExperiencesV1(context.Context, *ListUserExperiencesV1Request) (*ListUserExperiencesV1Response, error)
+	// GetUserExperienceSummaryV1 returns user experience duration, maximum level and dates per type.
+	// Overlapping experiences of a type are merged
+	GetUserExperienceSummaryV1(context.Context, *GetUserExperienceSummaryV1Request) (*GetUserExperienceSummaryV1Response, error)
+	// NormalizeUserExperiencesV1 merges overlapping experiences of a user type and adjacent ones of the same level.
+	// Merged experiences are removed and replaced with created ones, nothing is changed in dry run
+	NormalizeUserExperiencesV1(context.Context, *NormalizeUserExperiencesV1Request) (*NormalizeUserExperiencesV1Response, error)
 	// MultiCreateExperienceV1 creates multiple experiences, returns array of new ids
 	MultiCreateExperienceV1(context.Context, *MultiCreateExperienceV1Request) (*MultiCreateExperienceV1Response, error)
+	// ImportExperiencesV1 creates streamed experiences, returns import summary when stream is closed
+	ImportExperiencesV1(OcpExperienceApi_ImportExperiencesV1Server) error
+	// MultiRemoveExperienceV1 removes multiple experiences, returns a result per experience
+	MultiRemoveExperienceV1(context.Context, *MultiRemoveExperienceV1Request) (*MultiRemoveExperienceV1Response, error)
+	// MultiUpdateExperienceV1 updates multiple experiences, returns a result per experience
+	MultiUpdateExperienceV1(context.Context, *MultiUpdateExperienceV1Request) (*MultiUpdateExperienceV1Response, error)
+	// ListExperienceTypesV1 returns a list of experience types
+	ListExperienceTypesV1(context.Context, *ListExperienceTypesV1Request) (*ListExperienceTypesV1Response, error)
+	// DescribeExperienceTypeV1 returns experience type by id
+	DescribeExperienceTypeV1(context.Context, *DescribeExperienceTypeV1Request) (*DescribeExperienceTypeV1Response, error)
+	// CreateExperienceTypeV1 adds experience type to the catalog. Returns created type id
+	CreateExperienceTypeV1(context.Context, *CreateExperienceTypeV1Request) (*CreateExperienceTypeV1Response, error)
+	// UpdateExperienceTypeV1 renames experience type
+	UpdateExperienceTypeV1(context.Context, *UpdateExperienceTypeV1Request) (*UpdateExperienceTypeV1Response, error)
+	// RemoveExperienceTypeV1 removes experience type which is not used by experiences. Returns a removing result
+	RemoveExperienceTypeV1(context.Context, *RemoveExperienceTypeV1Request) (*RemoveExperienceTypeV1Response, error)
+	// ListExperienceLevelsV1 returns the level ladder of experience type
+	ListExperienceLevelsV1(context.Context, *ListExperienceLevelsV1Request) (*ListExperienceLevelsV1Response, error)
+	// SetExperienceLevelsV1 replaces the level ladder of experience type, an empty ladder allows any level
+	SetExperienceLevelsV1(context.Context, *SetExperienceLevelsV1Request) (*SetExperienceLevelsV1Response, error)
 	// UpdateExperienceV1 updates experience data
 	UpdateExperienceV1(context.Context, *UpdateExperienceV1Request) (*UpdateExperienceV1Response, error)
 	mustEmbedUnimplementedOcpExperienceApiServer()
@@ -120,6 +393,12 @@ type UnimplementedOcpExperienceApiServer struct {
 func (UnimplementedOcpExperienceApiServer) ListExperienceV1(context.Context, *ListExperienceV1Request) (*ListExperienceV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExperienceV1 not implemented")
 }
+func (UnimplementedOcpExperienceApiServer) ListExperienceV1Stream(*ListExperienceV1StreamRequest, OcpExperienceApi_ListExperienceV1StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListExperienceV1Stream not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) FindOverlapsV1(context.Context, *FindOverlapsV1Request) (*FindOverlapsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOverlapsV1 not implemented")
+}
 func (UnimplementedOcpExperienceApiServer) DescribeExperienceV1(context.Context, *DescribeExperienceV1Request) (*DescribeExperienceV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeExperienceV1 not implemented")
 }
@@ -129,9 +408,54 @@ func (UnimplementedOcpExperienceApiServer) CreateExperienceV1(context.Context, *
 func (UnimplementedOcpExperienceApiServer) RemoveExperienceV1(context.Context, *RemoveExperienceV1Request) (*RemoveExperienceV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveExperienceV1 not implemented")
 }
+func (UnimplementedOcpExperienceApiServer) RestoreExperienceV1(context.Context, *RestoreExperienceV1Request) (*RestoreExperienceV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreExperienceV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) ListExperienceHistoryV1(context.Context, *ListExperienceHistoryV1Request) (*ListExperienceHistoryV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperienceHistoryV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) ListUserExperiencesV1(context.Context, *ListUserExperiencesV1Request) (*ListUserExperiencesV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserExperiencesV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) GetUserExperienceSummaryV1(context.Context, *GetUserExperienceSummaryV1Request) (*GetUserExperienceSummaryV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserExperienceSummaryV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) NormalizeUserExperiencesV1(context.Context, *NormalizeUserExperiencesV1Request) (*NormalizeUserExperiencesV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NormalizeUserExperiencesV1 not implemented")
+}
 func (UnimplementedOcpExperienceApiServer) MultiCreateExperienceV1(context.Context, *MultiCreateExperienceV1Request) (*MultiCreateExperienceV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateExperienceV1 not implemented")
 }
+func (UnimplementedOcpExperienceApiServer) ImportExperiencesV1(OcpExperienceApi_ImportExperiencesV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ImportExperiencesV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) MultiRemoveExperienceV1(context.Context, *MultiRemoveExperienceV1Request) (*MultiRemoveExperienceV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiRemoveExperienceV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) MultiUpdateExperienceV1(context.Context, *MultiUpdateExperienceV1Request) (*MultiUpdateExperienceV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiUpdateExperienceV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) ListExperienceTypesV1(context.Context, *ListExperienceTypesV1Request) (*ListExperienceTypesV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperienceTypesV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) DescribeExperienceTypeV1(context.Context, *DescribeExperienceTypeV1Request) (*DescribeExperienceTypeV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeExperienceTypeV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) CreateExperienceTypeV1(context.Context, *CreateExperienceTypeV1Request) (*CreateExperienceTypeV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperienceTypeV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) UpdateExperienceTypeV1(context.Context, *UpdateExperienceTypeV1Request) (*UpdateExperienceTypeV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExperienceTypeV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) RemoveExperienceTypeV1(context.Context, *RemoveExperienceTypeV1Request) (*RemoveExperienceTypeV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExperienceTypeV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) ListExperienceLevelsV1(context.Context, *ListExperienceLevelsV1Request) (*ListExperienceLevelsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperienceLevelsV1 not implemented")
+}
+func (UnimplementedOcpExperienceApiServer) SetExperienceLevelsV1(context.Context, *SetExperienceLevelsV1Request) (*SetExperienceLevelsV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExperienceLevelsV1 not implemented")
+}
 func (UnimplementedOcpExperienceApiServer) UpdateExperienceV1(context.Context, *UpdateExperienceV1Request) (*UpdateExperienceV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateExperienceV1 not implemented")
 }
@@ -166,6 +490,45 @@ func _OcpExperienceApi_ListExperienceV1_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpExperienceApi_ListExperienceV1Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListExperienceV1StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OcpExperienceApiServer).ListExperienceV1Stream(m, &ocpExperienceApiListExperienceV1StreamServer{stream})
+}
+
+type OcpExperienceApi_ListExperienceV1StreamServer interface {
+	Send(*ListExperienceV1StreamResponse) error
+	grpc.ServerStream
+}
+
+type ocpExperienceApiListExperienceV1StreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ocpExperienceApiListExperienceV1StreamServer) Send(m *ListExperienceV1StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OcpExperienceApi_FindOverlapsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOverlapsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).FindOverlapsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/FindOverlapsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).FindOverlapsV1(ctx, req.(*FindOverlapsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpExperienceApi_DescribeExperienceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeExperienceV1Request)
 	if err := dec(in); err != nil {
@@ -220,6 +583,96 @@ func _OcpExperienceApi_RemoveExperienceV1_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpExperienceApi_RestoreExperienceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreExperienceV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).RestoreExperienceV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/RestoreExperienceV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).RestoreExperienceV1(ctx, req.(*RestoreExperienceV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_ListExperienceHistoryV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperienceHistoryV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).ListExperienceHistoryV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/ListExperienceHistoryV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).ListExperienceHistoryV1(ctx, req.(*ListExperienceHistoryV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_ListUserExperiencesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserExperiencesV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).ListUserExperiencesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/ListUserExperiencesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).ListUserExperiencesV1(ctx, req.(*ListUserExperiencesV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_GetUserExperienceSummaryV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserExperienceSummaryV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).GetUserExperienceSummaryV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/GetUserExperienceSummaryV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).GetUserExperienceSummaryV1(ctx, req.(*GetUserExperienceSummaryV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_NormalizeUserExperiencesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizeUserExperiencesV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).NormalizeUserExperiencesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/NormalizeUserExperiencesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).NormalizeUserExperiencesV1(ctx, req.(*NormalizeUserExperiencesV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpExperienceApi_MultiCreateExperienceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MultiCreateExperienceV1Request)
 	if err := dec(in); err != nil {
@@ -238,6 +691,194 @@ func _OcpExperienceApi_MultiCreateExperienceV1_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpExperienceApi_ImportExperiencesV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OcpExperienceApiServer).ImportExperiencesV1(&ocpExperienceApiImportExperiencesV1Server{stream})
+}
+
+type OcpExperienceApi_ImportExperiencesV1Server interface {
+	SendAndClose(*ImportExperiencesV1Response) error
+	Recv() (*ImportExperiencesV1Request, error)
+	grpc.ServerStream
+}
+
+type ocpExperienceApiImportExperiencesV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *ocpExperienceApiImportExperiencesV1Server) SendAndClose(m *ImportExperiencesV1Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ocpExperienceApiImportExperiencesV1Server) Recv() (*ImportExperiencesV1Request, error) {
+	m := new(ImportExperiencesV1Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _OcpExperienceApi_MultiRemoveExperienceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiRemoveExperienceV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).MultiRemoveExperienceV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/MultiRemoveExperienceV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).MultiRemoveExperienceV1(ctx, req.(*MultiRemoveExperienceV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_MultiUpdateExperienceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiUpdateExperienceV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).MultiUpdateExperienceV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/MultiUpdateExperienceV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).MultiUpdateExperienceV1(ctx, req.(*MultiUpdateExperienceV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_ListExperienceTypesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperienceTypesV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).ListExperienceTypesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/ListExperienceTypesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).ListExperienceTypesV1(ctx, req.(*ListExperienceTypesV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_DescribeExperienceTypeV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeExperienceTypeV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).DescribeExperienceTypeV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/DescribeExperienceTypeV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).DescribeExperienceTypeV1(ctx, req.(*DescribeExperienceTypeV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_CreateExperienceTypeV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperienceTypeV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).CreateExperienceTypeV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/CreateExperienceTypeV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).CreateExperienceTypeV1(ctx, req.(*CreateExperienceTypeV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_UpdateExperienceTypeV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExperienceTypeV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).UpdateExperienceTypeV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/UpdateExperienceTypeV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).UpdateExperienceTypeV1(ctx, req.(*UpdateExperienceTypeV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_RemoveExperienceTypeV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveExperienceTypeV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).RemoveExperienceTypeV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/RemoveExperienceTypeV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).RemoveExperienceTypeV1(ctx, req.(*RemoveExperienceTypeV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_ListExperienceLevelsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperienceLevelsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).ListExperienceLevelsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/ListExperienceLevelsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).ListExperienceLevelsV1(ctx, req.(*ListExperienceLevelsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpExperienceApi_SetExperienceLevelsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExperienceLevelsV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpExperienceApiServer).SetExperienceLevelsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.experience.api.OcpExperienceApi/SetExperienceLevelsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpExperienceApiServer).SetExperienceLevelsV1(ctx, req.(*SetExperienceLevelsV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpExperienceApi_UpdateExperienceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateExperienceV1Request)
 	if err := dec(in); err != nil {
@@ -267,6 +908,10 @@ var OcpExperienceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListExperienceV1",
 			Handler:    _OcpExperienceApi_ListExperienceV1_Handler,
 		},
+		{
+			MethodName: "FindOverlapsV1",
+			Handler:    _OcpExperienceApi_FindOverlapsV1_Handler,
+		},
 		{
 			MethodName: "DescribeExperienceV1",
 			Handler:    _OcpExperienceApi_DescribeExperienceV1_Handler,
@@ -279,15 +924,82 @@ var OcpExperienceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveExperienceV1",
 			Handler:    _OcpExperienceApi_RemoveExperienceV1_Handler,
 		},
+		{
+			MethodName: "RestoreExperienceV1",
+			Handler:    _OcpExperienceApi_RestoreExperienceV1_Handler,
+		},
+		{
+			MethodName: "ListExperienceHistoryV1",
+			Handler:    _OcpExperienceApi_ListExperienceHistoryV1_Handler,
+		},
+		{
+			MethodName: "ListUserExperiencesV1",
+			Handler:    _OcpExperienceApi_ListUserExperiencesV1_Handler,
+		},
+		{
+			MethodName: "GetUserExperienceSummaryV1",
+			Handler:    _OcpExperienceApi_GetUserExperienceSummaryV1_Handler,
+		},
+		{
+			MethodName: "NormalizeUserExperiencesV1",
+			Handler:    _OcpExperienceApi_NormalizeUserExperiencesV1_Handler,
+		},
 		{
 			MethodName: "MultiCreateExperienceV1",
 			Handler:    _OcpExperienceApi_MultiCreateExperienceV1_Handler,
 		},
+		{
+			MethodName: "MultiRemoveExperienceV1",
+			Handler:    _OcpExperienceApi_MultiRemoveExperienceV1_Handler,
+		},
+		{
+			MethodName: "MultiUpdateExperienceV1",
+			Handler:    _OcpExperienceApi_MultiUpdateExperienceV1_Handler,
+		},
+		{
+			MethodName: "ListExperienceTypesV1",
+			Handler:    _OcpExperienceApi_ListExperienceTypesV1_Handler,
+		},
+		{
+			MethodName: "DescribeExperienceTypeV1",
+			Handler:    _OcpExperienceApi_DescribeExperienceTypeV1_Handler,
+		},
+		{
+			MethodName: "CreateExperienceTypeV1",
+			Handler:    _OcpExperienceApi_CreateExperienceTypeV1_Handler,
+		},
+		{
+			MethodName: "UpdateExperienceTypeV1",
+			Handler:    _OcpExperienceApi_UpdateExperienceTypeV1_Handler,
+		},
+		{
+			MethodName: "RemoveExperienceTypeV1",
+			Handler:    _OcpExperienceApi_RemoveExperienceTypeV1_Handler,
+		},
+		{
+			MethodName: "ListExperienceLevelsV1",
+			Handler:    _OcpExperienceApi_ListExperienceLevelsV1_Handler,
+		},
+		{
+			MethodName: "SetExperienceLevelsV1",
+			Handler:    _OcpExperienceApi_SetExperienceLevelsV1_Handler,
+		},
 		{
 			MethodName: "UpdateExperienceV1",
 			Handler:    _OcpExperienceApi_UpdateExperienceV1_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListExperienceV1Stream",
+			Handler:       _OcpExperienceApi_ListExperienceV1Stream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportExperiencesV1",
+			Handler:       _OcpExperienceApi_ImportExperiencesV1_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "api/ocp-experience-api/ocp-experience-api.proto",
 }